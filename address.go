@@ -0,0 +1,136 @@
+package slip10
+
+import (
+	"fmt"
+	"math"
+)
+
+var (
+	ErrIndexOverflow = fmt.Errorf("index overflow")
+	ErrNoParent      = fmt.Errorf("node has no parent")
+	ErrNoAncestry    = fmt.Errorf("node does not keep its ancestors")
+	ErrInvalidDepth  = fmt.Errorf("invalid depth")
+)
+
+// NextAddress derives the next sibling of the node, the child of the same parent
+// with the index one greater. The parent is taken from the ancestors, so only nodes
+// derived WithAncestry and their descendants have a next address; master nodes and
+// other nodes return ErrNoParent. It returns ErrIndexOverflow instead of wrapping around
+// when the index is the last one, 2^31-1 for hardened child numbers and 2^32-1 for raw indices.
+func (k *node) NextAddress() (Node, error) {
+	if k == nil {
+		return nil, ErrNilNode
+	}
+	if k.depth == 0 || k.ancestors == nil {
+		return nil, ErrNoParent
+	}
+	if k.index == MaxIndex(k.index >= FirstHardenedIndex) {
+		return nil, ErrIndexOverflow
+	}
+
+	parent, err := k.AncestorAt(int(k.depth) - 1)
+	if err != nil {
+		return nil, err
+	}
+	return parent.Derive(k.index + 1)
+}
+
+// AncestorAt returns the ancestor of the node at the depth, e.g. 3 for the account node
+// of a BIP-44 address, or the node itself for its own depth. Ancestors are kept only by nodes
+// derived WithAncestry and their descendants; other nodes return ErrNoAncestry.
+// A depth below 0 or greater than the node's returns ErrInvalidDepth.
+func (k *node) AncestorAt(depth int) (Node, error) {
+	if k == nil {
+		return nil, ErrNilNode
+	}
+	if depth < 0 || depth > int(k.depth) {
+		return nil, fmt.Errorf("%w: %d, node is at depth %d", ErrInvalidDepth, depth, k.depth)
+	}
+	if depth == int(k.depth) {
+		return k, nil
+	}
+	if k.ancestors == nil {
+		return nil, ErrNoAncestry
+	}
+
+	// the ancestor keeps its own ancestors, so it can be navigated like the node
+	ancestor := *k.ancestors[depth]
+	ancestor.ancestors = k.ancestors[:depth:depth]
+	return &ancestor, nil
+}
+
+// DeriveAddressSets derives the receive addresses node/0'/i' for i below receiveCount
+// and the change addresses node/1'/j' for j below changeCount, deriving each change level once.
+// Ed25519 has no public derivation, so both levels are hardened and the node must hold
+// its private key; secp256k1 wallets use non-hardened node/0/i and node/1/j instead.
+// It returns ErrIndexOverflow if a count is greater than 2^31, the number of hardened children.
+func DeriveAddressSets(node Node, receiveCount, changeCount uint32) (receive, change []Node, err error) {
+	if receiveCount > FirstHardenedIndex || changeCount > FirstHardenedIndex {
+		return nil, nil, ErrIndexOverflow
+	}
+
+	receive, err = deriveChildren(node, FirstHardenedIndex, receiveCount)
+	if err != nil {
+		return nil, nil, err
+	}
+	change, err = deriveChildren(node, FirstHardenedIndex+1, changeCount)
+	if err != nil {
+		return nil, nil, err
+	}
+	return receive, change, nil
+}
+
+// deriveChildren derives the first count hardened children of the child chain of node.
+func deriveChildren(node Node, chain, count uint32) ([]Node, error) {
+	if count == 0 {
+		return []Node{}, nil
+	}
+	parent, err := node.Derive(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	children := make([]Node, count)
+	for i := range children {
+		children[i], err = parent.Derive(FirstHardenedIndex + uint32(i))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return children, nil
+}
+
+// MaxIndex returns the largest raw index passed to Derive for hardened or non-hardened children:
+// 2^32-1 for hardened ones, whose indices run from FirstHardenedIndex to 2^32-1,
+// and FirstHardenedIndex-1 for non-hardened ones, whose indices run from 0.
+// Ed25519 derives hardened children only.
+func MaxIndex(hardened bool) uint32 {
+	if hardened {
+		return math.MaxUint32
+	}
+	return FirstHardenedIndex - 1
+}
+
+// detached returns a copy of the node without its ancestors, so that a chain
+// of derived nodes does not keep all their ancestors alive.
+// The key and chain code are copied, so wiping the original leaves the copy intact.
+func (k *node) detached() *node {
+	c := *k
+	c.key = append([]byte(nil), k.key...)
+	c.chainCode = append([]byte(nil), k.chainCode...)
+	c.ancestors = nil
+	return &c
+}
+
+// wipe zeroes the key and chain code of the node and of its ancestor copies, and drops the copies.
+// Ancestor copies are shared by the nodes derived WithAncestry from the same chain,
+// so wiping one of them wipes the ancestors of the others too.
+func (k *node) wipe() {
+	clear(k.key)
+	clear(k.chainCode)
+	for _, ancestor := range k.ancestors {
+		clear(ancestor.key)
+		clear(ancestor.chainCode)
+	}
+	k.ancestors = nil
+}
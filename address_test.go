@@ -0,0 +1,301 @@
+package slip10
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNode_NextAddress(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	master, err := NewMasterNode(seed)
+	if err != nil {
+		t.Fatalf("NewMasterNode() error = %v", err)
+	}
+	parent, err := DeriveForPath("m/0'", seed, WithAncestry())
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	untracked, err := DeriveForPath("m/0'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	last, err := parent.Derive(math.MaxUint32)
+	if err != nil {
+		t.Fatalf("Derive() error = %v", err)
+	}
+	checkpoint, err := parent.Checkpoint(testMACKey)
+	if err != nil {
+		t.Fatalf("Checkpoint() error = %v", err)
+	}
+	resumed, err := ResumeFromCheckpoint(checkpoint, testMACKey)
+	if err != nil {
+		t.Fatalf("ResumeFromCheckpoint() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		node     Node
+		wantPath string
+		wantErr  error
+	}{
+		{name: "first child", node: parent, wantPath: "m/1'"},
+		{name: "last child", node: last, wantErr: ErrIndexOverflow},
+		{name: "master", node: master, wantErr: ErrNoParent},
+		{name: "without ancestry", node: untracked, wantErr: ErrNoParent},
+		{name: "resumed", node: resumed, wantErr: ErrNoParent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.node.NextAddress()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NextAddress() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			want, err := DeriveForPath(tt.wantPath, seed)
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+			if !bytes.Equal(got.RawSeed(), want.RawSeed()) {
+				t.Errorf("NextAddress() = %x, want %x", got.RawSeed(), want.RawSeed())
+			}
+			path, err := got.Path()
+			if err != nil {
+				t.Fatalf("Path() error = %v", err)
+			}
+			if path != tt.wantPath {
+				t.Errorf("NextAddress().Path() = %v, want %v", path, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestNode_NextAddress_Iterate(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	key, err := DeriveForPath("m/0'/0'", seed, WithAncestry())
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		key, err = key.NextAddress()
+		if err != nil {
+			t.Fatalf("NextAddress() error = %v", err)
+		}
+	}
+
+	want, err := DeriveForPath("m/0'/3'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	if !bytes.Equal(key.RawSeed(), want.RawSeed()) {
+		t.Errorf("NextAddress() = %x, want %x", key.RawSeed(), want.RawSeed())
+	}
+}
+
+func TestNode_Derive_NoParentCopy(t *testing.T) {
+	// without WithAncestry a child keeps no copy of its parent, so it cannot derive its siblings
+	master, err := NewMasterNode(hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("NewMasterNode() error = %v", err)
+	}
+	child, err := master.Derive(FirstHardenedIndex)
+	if err != nil {
+		t.Fatalf("Derive() error = %v", err)
+	}
+	grandchild, err := child.Derive(FirstHardenedIndex)
+	if err != nil {
+		t.Fatalf("Derive() error = %v", err)
+	}
+
+	for _, k := range []*node{child.(*node), grandchild.(*node)} {
+		if k.ancestors != nil {
+			t.Errorf("node at depth %d keeps %d ancestors", k.depth, len(k.ancestors))
+		}
+	}
+}
+
+func TestNode_Wipe(t *testing.T) {
+	key, err := DeriveForPath("m/0'/1'", hexMustDecode("000102030405060708090a0b0c0d0e0f"), WithAncestry())
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	k := key.(*node)
+	ancestors := k.ancestors
+
+	k.wipe()
+
+	if k.ancestors != nil {
+		t.Errorf("wipe() kept %d ancestors", len(k.ancestors))
+	}
+	zero := make([]byte, Ed25519KeyLen)
+	for _, n := range append(ancestors, k) {
+		if !bytes.Equal(n.key, zero) || !bytes.Equal(n.chainCode, zero) {
+			t.Errorf("wipe() left the key or chain code at depth %d", n.depth)
+		}
+	}
+	if _, err := k.NextAddress(); err != ErrNoParent {
+		t.Errorf("NextAddress() after wipe() error = %v, wantErr %v", err, ErrNoParent)
+	}
+}
+
+func TestMaxIndex(t *testing.T) {
+	if got := MaxIndex(true); got != math.MaxUint32 {
+		t.Errorf("MaxIndex(true) = %d, want %d", got, uint32(math.MaxUint32))
+	}
+	if got := MaxIndex(false); got != FirstHardenedIndex-1 {
+		t.Errorf("MaxIndex(false) = %d, want %d", got, FirstHardenedIndex-1)
+	}
+
+	master, err := NewMasterNode(hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("NewMasterNode() error = %v", err)
+	}
+	if _, err := master.Derive(MaxIndex(true)); err != nil {
+		t.Errorf("Derive(MaxIndex(true)) error = %v", err)
+	}
+}
+
+func TestNode_AncestorAt(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	address, err := DeriveForPath("m/44'/501'/0'/0'", seed, WithAncestry())
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	// descendants of a node derived WithAncestry keep their ancestors too
+	child, err := address.Derive(FirstHardenedIndex + 7)
+	if err != nil {
+		t.Fatalf("Derive() error = %v", err)
+	}
+
+	paths := []string{"m", "m/44'", "m/44'/501'", "m/44'/501'/0'", "m/44'/501'/0'/0'", "m/44'/501'/0'/0'/7'"}
+	for depth, path := range paths {
+		t.Run(path, func(t *testing.T) {
+			want, err := DeriveForPath(path, seed)
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+
+			got, err := child.AncestorAt(depth)
+			if err != nil {
+				t.Fatalf("AncestorAt() error = %v", err)
+			}
+			if !bytes.Equal(got.HMACOutput(), want.HMACOutput()) {
+				t.Errorf("AncestorAt(%d) = %x, want %x", depth, got.HMACOutput(), want.HMACOutput())
+			}
+			gotPath, err := got.Path()
+			if err != nil {
+				t.Fatalf("Path() error = %v", err)
+			}
+			if gotPath != path {
+				t.Errorf("AncestorAt(%d).Path() = %v, want %v", depth, gotPath, path)
+			}
+		})
+	}
+
+	// an ancestor can be navigated like the node
+	account, err := child.AncestorAt(3)
+	if err != nil {
+		t.Fatalf("AncestorAt() error = %v", err)
+	}
+	coin, err := account.AncestorAt(2)
+	if err != nil {
+		t.Fatalf("AncestorAt() error = %v", err)
+	}
+	if path, _ := coin.Path(); path != "m/44'/501'" {
+		t.Errorf("AncestorAt(2) of the account = %v, want m/44'/501'", path)
+	}
+	next, err := account.NextAddress()
+	if err != nil {
+		t.Fatalf("NextAddress() error = %v", err)
+	}
+	if path, _ := next.Path(); path != "m/44'/501'/1'" {
+		t.Errorf("NextAddress() of the account = %v, want m/44'/501'/1'", path)
+	}
+}
+
+func TestNode_AncestorAt_Invalid(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	tracked, err := DeriveForPath("m/0'/1'", seed, WithAncestry())
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	untracked, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		node    Node
+		depth   int
+		wantErr error
+	}{
+		{name: "negative depth", node: tracked, depth: -1, wantErr: ErrInvalidDepth},
+		{name: "below the node", node: tracked, depth: 3, wantErr: ErrInvalidDepth},
+		{name: "no ancestry", node: untracked, depth: 1, wantErr: ErrNoAncestry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.node.AncestorAt(tt.depth); !errors.Is(err, tt.wantErr) {
+				t.Errorf("AncestorAt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// the node itself is always available
+	if got, err := untracked.AncestorAt(2); err != nil || got != untracked {
+		t.Errorf("AncestorAt(2) = %v, %v, want the node", got, err)
+	}
+}
+
+func TestDeriveAddressSets(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	account, err := DeriveForPath("m/44'/501'/0'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	receive, change, err := DeriveAddressSets(account, 3, 2)
+	if err != nil {
+		t.Fatalf("DeriveAddressSets() error = %v", err)
+	}
+	wantReceive := []string{"m/44'/501'/0'/0'/0'", "m/44'/501'/0'/0'/1'", "m/44'/501'/0'/0'/2'"}
+	wantChange := []string{"m/44'/501'/0'/1'/0'", "m/44'/501'/0'/1'/1'"}
+	for _, set := range []struct {
+		name  string
+		got   []Node
+		paths []string
+	}{
+		{name: "receive", got: receive, paths: wantReceive},
+		{name: "change", got: change, paths: wantChange},
+	} {
+		if len(set.got) != len(set.paths) {
+			t.Fatalf("DeriveAddressSets() returned %d %s addresses, want %d", len(set.got), set.name, len(set.paths))
+		}
+		for i, path := range set.paths {
+			want, err := DeriveForPath(path, seed)
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+			if !bytes.Equal(set.got[i].RawSeed(), want.RawSeed()) {
+				t.Errorf("%s[%d] = %x, want %s %x", set.name, i, set.got[i].RawSeed(), path, want.RawSeed())
+			}
+		}
+	}
+
+	receive, change, err = DeriveAddressSets(account, 0, 0)
+	if err != nil || len(receive) != 0 || len(change) != 0 {
+		t.Errorf("DeriveAddressSets(0, 0) = %v, %v, %v, want empty sets", receive, change, err)
+	}
+	if _, _, err := DeriveAddressSets(account, FirstHardenedIndex+1, 0); err != ErrIndexOverflow {
+		t.Errorf("DeriveAddressSets() error = %v, wantErr %v", err, ErrIndexOverflow)
+	}
+	if _, _, err := DeriveAddressSets(&node{}, 1, 1); !errors.Is(err, ErrCannotDerive) {
+		t.Errorf("DeriveAddressSets() error = %v, wantErr %v", err, ErrCannotDerive)
+	}
+}
@@ -0,0 +1,141 @@
+package slip10
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const (
+	archiveVersion = 1
+	// version || master fingerprint || node count
+	archiveHeaderLen = 1 + 4 + 4
+	// depth || indices || chain code || key, without the indices
+	archiveNodeLen  = 1 + ChainCodeLen + Ed25519KeyLen
+	archiveModifier = "slip10 archive"
+)
+
+var ErrInvalidArchive = fmt.Errorf("invalid archive")
+
+// ExportArchive derives the paths with the deriver and writes the nodes to w
+// in a versioned binary archive that ImportArchive restores without the seed.
+// Paths are in the format of DeriveForPath; a path given twice is written once.
+// The archive contains the private keys and is not encrypted: encrypting it at rest
+// is the caller's responsibility. Like Checkpoint, it carries an HMAC-SHA256 keyed with macKey,
+// so that ImportArchive rejects archives modified by anyone who does not hold the key.
+// macKey must be secret and at least MinMACKeyLen bytes long, otherwise ErrInvalidMACKey is returned.
+func ExportArchive(w io.Writer, deriver *Deriver, paths []string, macKey []byte) error {
+	if len(macKey) < MinMACKeyLen {
+		return ErrInvalidMACKey
+	}
+	master := deriver.Master()
+	if master == nil {
+		return ErrDeriverWiped
+	}
+	fp := master.Fingerprint()
+
+	var body []byte
+	seen := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		derived, err := deriver.ForPath(path)
+		if err != nil {
+			return fmt.Errorf("%w: %q", err, path)
+		}
+		k := derived.(*node)
+		canonical := formatPath(k.path)
+		if seen[canonical] {
+			continue
+		}
+		seen[canonical] = true
+
+		body = append(body, k.depth)
+		for _, i := range k.path {
+			body = binary.BigEndian.AppendUint32(body, i)
+		}
+		body = append(body, k.chainCode...)
+		body = append(body, k.key...)
+	}
+
+	archive := make([]byte, 0, archiveHeaderLen+len(body)+sha256.Size)
+	archive = append(archive, archiveVersion)
+	archive = append(archive, fp[:]...)
+	archive = binary.BigEndian.AppendUint32(archive, uint32(len(seen)))
+	archive = append(archive, body...)
+	archive = append(archive, keyedMAC(macKey, archiveModifier, archive)...)
+	clear(body)
+	defer clear(archive)
+
+	_, err := w.Write(archive)
+	return err
+}
+
+// ImportArchive restores the nodes written by ExportArchive with the same macKey,
+// keyed by their canonical path. Restored nodes keep their depth, index and origin,
+// but have no parent, so NextAddress returns ErrNoParent.
+// It returns ErrInvalidArchive for corrupted, truncated or forged archives.
+func ImportArchive(r io.Reader, macKey []byte) (nodes map[string]Node, err error) {
+	if len(macKey) < MinMACKeyLen {
+		return nil, ErrInvalidMACKey
+	}
+	archive, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(archive)
+
+	if len(archive) < archiveHeaderLen+sha256.Size || archive[0] != archiveVersion {
+		return nil, ErrInvalidArchive
+	}
+	payload, mac := archive[:len(archive)-sha256.Size], archive[len(archive)-sha256.Size:]
+	if !hmac.Equal(mac, keyedMAC(macKey, archiveModifier, payload)) {
+		return nil, ErrInvalidArchive
+	}
+
+	var fp [4]byte
+	copy(fp[:], payload[1:5])
+	count := binary.BigEndian.Uint32(payload[5:9])
+	body := payload[archiveHeaderLen:]
+
+	nodes = make(map[string]Node)
+	for range count {
+		if len(body) < archiveNodeLen {
+			return nil, ErrInvalidArchive
+		}
+		depth := int(body[0])
+		if len(body) < archiveNodeLen+4*depth {
+			return nil, ErrInvalidArchive
+		}
+
+		path := make([]uint32, depth)
+		for i := range path {
+			path[i] = binary.BigEndian.Uint32(body[1+4*i:])
+		}
+		body = body[1+4*depth:]
+
+		k := &node{
+			chainCode:         append([]byte(nil), body[:ChainCodeLen]...),
+			key:               append([]byte(nil), body[ChainCodeLen:ChainCodeLen+Ed25519KeyLen]...),
+			depth:             uint8(depth),
+			hasOrigin:         true,
+			masterFingerprint: fp,
+			path:              path,
+		}
+		if depth > 0 {
+			k.index = path[depth-1]
+		}
+		body = body[ChainCodeLen+Ed25519KeyLen:]
+
+		canonical := formatPath(path)
+		if _, ok := nodes[canonical]; ok {
+			return nil, ErrInvalidArchive
+		}
+		nodes[canonical] = k
+	}
+	if len(body) != 0 {
+		return nil, ErrInvalidArchive
+	}
+
+	return nodes, nil
+}
@@ -0,0 +1,136 @@
+package slip10
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestImportArchive(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	deriver, err := NewDeriver(seed)
+	if err != nil {
+		t.Fatalf("NewDeriver() error = %v", err)
+	}
+
+	paths := []string{"m", "m/0'", "m/0'/1'", "m/44'/501'/0'/0'", "m/0'"}
+	var buf bytes.Buffer
+	if err := ExportArchive(&buf, deriver, paths, testMACKey); err != nil {
+		t.Fatalf("ExportArchive() error = %v", err)
+	}
+
+	nodes, err := ImportArchive(&buf, testMACKey)
+	if err != nil {
+		t.Fatalf("ImportArchive() error = %v", err)
+	}
+	if len(nodes) != 4 {
+		t.Errorf("ImportArchive() returned %d nodes, want 4", len(nodes))
+	}
+	for _, path := range paths {
+		want, err := DeriveForPath(path, seed)
+		if err != nil {
+			t.Fatalf("DeriveForPath() error = %v", err)
+		}
+		got, ok := nodes[path]
+		if !ok {
+			t.Fatalf("ImportArchive() has no node for %q", path)
+		}
+
+		if !bytes.Equal(got.HMACOutput(), want.HMACOutput()) {
+			t.Errorf("%s: HMACOutput() = %x, want %x", path, got.HMACOutput(), want.HMACOutput())
+		}
+		if g, w := got.(*node), want.(*node); g.depth != w.depth || g.index != w.index {
+			t.Errorf("%s: depth, index = %d, %d, want %d, %d", path, g.depth, g.index, w.depth, w.index)
+		}
+		gotPath, err := got.Path()
+		if err != nil {
+			t.Fatalf("Path() error = %v", err)
+		}
+		if gotPath != path {
+			t.Errorf("Path() = %v, want %v", gotPath, path)
+		}
+		gotFP, err := got.MasterFingerprint()
+		if err != nil {
+			t.Fatalf("MasterFingerprint() error = %v", err)
+		}
+		if wantFP, _ := want.MasterFingerprint(); gotFP != wantFP {
+			t.Errorf("MasterFingerprint() = %x, want %x", gotFP, wantFP)
+		}
+	}
+}
+
+func TestImportArchive_Invalid(t *testing.T) {
+	deriver, err := NewDeriver(hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("NewDeriver() error = %v", err)
+	}
+	var buf bytes.Buffer
+	if err := ExportArchive(&buf, deriver, []string{"m/0'", "m/0'/1'"}, testMACKey); err != nil {
+		t.Fatalf("ExportArchive() error = %v", err)
+	}
+	archive := buf.Bytes()
+
+	tampered := append([]byte(nil), archive...)
+	tampered[40] ^= 0x01
+
+	badVersion := append([]byte(nil), archive...)
+	badVersion[0] = 0xff
+
+	// an attacker without the key recomputes the tag of a modified archive with their own key
+	forged := append([]byte(nil), archive[:len(archive)-32]...)
+	forged[40] ^= 0x01
+	forged = append(forged, keyedMAC([]byte("attacker key 0123456789"), archiveModifier, forged)...)
+
+	tests := []struct {
+		name    string
+		archive []byte
+	}{
+		{name: "empty", archive: nil},
+		{name: "truncated", archive: archive[:len(archive)-1]},
+		{name: "tampered", archive: tampered},
+		{name: "bad version", archive: badVersion},
+		{name: "forged", archive: forged},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ImportArchive(bytes.NewReader(tt.archive), testMACKey); !errors.Is(err, ErrInvalidArchive) {
+				t.Errorf("ImportArchive() error = %v, wantErr %v", err, ErrInvalidArchive)
+			}
+		})
+	}
+}
+
+func TestExportArchive_Invalid(t *testing.T) {
+	deriver, err := NewDeriver(hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("NewDeriver() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := ExportArchive(&buf, deriver, []string{"m/0"}, testMACKey); !errors.Is(err, ErrInvalidPath) {
+		t.Errorf("ExportArchive() error = %v, wantErr %v", err, ErrInvalidPath)
+	}
+
+	deriver.Wipe()
+	if err := ExportArchive(&buf, deriver, []string{"m/0'"}, testMACKey); !errors.Is(err, ErrDeriverWiped) {
+		t.Errorf("ExportArchive() error = %v, wantErr %v", err, ErrDeriverWiped)
+	}
+}
+
+func TestArchive_InvalidMACKey(t *testing.T) {
+	deriver, err := NewDeriver(hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("NewDeriver() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := ExportArchive(&buf, deriver, []string{"m/0'"}, make([]byte, MinMACKeyLen-1)); err != ErrInvalidMACKey {
+		t.Errorf("ExportArchive() error = %v, wantErr %v", err, ErrInvalidMACKey)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("ExportArchive() wrote %d bytes with an invalid key", buf.Len())
+	}
+	if _, err := ImportArchive(&buf, nil); err != ErrInvalidMACKey {
+		t.Errorf("ImportArchive() error = %v, wantErr %v", err, ErrInvalidMACKey)
+	}
+}
@@ -0,0 +1,77 @@
+package slip10
+
+import (
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidBase58 = fmt.Errorf("invalid base58 string")
+
+// base58Alphabet is the Bitcoin alphabet, also used by Solana addresses.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// base58Encode encodes data in base58 with the Bitcoin alphabet,
+// keeping every leading zero byte as a leading "1".
+func base58Encode(data []byte) string {
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+
+	// log(256) / log(58) < 1.37
+	digits := make([]byte, 0, len(data)*137/100+1)
+	for _, b := range data[zeros:] {
+		carry := int(b)
+		for i := range digits {
+			carry += int(digits[i]) << 8
+			digits[i] = byte(carry % 58)
+			carry /= 58
+		}
+		for carry > 0 {
+			digits = append(digits, byte(carry%58))
+			carry /= 58
+		}
+	}
+
+	buf := make([]byte, zeros+len(digits))
+	for i := 0; i < zeros; i++ {
+		buf[i] = base58Alphabet[0]
+	}
+	for i, d := range digits {
+		buf[len(buf)-1-i] = base58Alphabet[d]
+	}
+	return string(buf)
+}
+
+// base58Decode decodes a base58 string with the Bitcoin alphabet, turning every leading "1"
+// into a leading zero byte. It returns ErrInvalidBase58 for characters outside the alphabet.
+func base58Decode(s string) ([]byte, error) {
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+
+	// log(58) / log(256) < 0.74
+	bytes := make([]byte, 0, len(s)*74/100+1)
+	for i := zeros; i < len(s); i++ {
+		carry := strings.IndexByte(base58Alphabet, s[i])
+		if carry < 0 {
+			return nil, ErrInvalidBase58
+		}
+		for j := range bytes {
+			carry += int(bytes[j]) * 58
+			bytes[j] = byte(carry)
+			carry >>= 8
+		}
+		for carry > 0 {
+			bytes = append(bytes, byte(carry))
+			carry >>= 8
+		}
+	}
+
+	buf := make([]byte, zeros+len(bytes))
+	for i, b := range bytes {
+		buf[len(buf)-1-i] = b
+	}
+	return buf, nil
+}
@@ -0,0 +1,55 @@
+package slip10
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBase58Encode(t *testing.T) {
+	// vectors from https://datatracker.ietf.org/doc/html/draft-msporny-base58-03
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{name: "empty", data: nil, want: ""},
+		{name: "text", data: []byte("Hello World!"), want: "2NEpo7TZRRrLZSi2U"},
+		{name: "sentence", data: []byte("The quick brown fox jumps over the lazy dog."), want: "USm3fpXnKG5EUBx2ndxBDMPVciP5hGey2Jh4NDv6gmeo1LkMeiKrLJUUBk6Z"},
+		{name: "leading zeros", data: hexMustDecode("0000287fb4cd"), want: "11233QC4"},
+		// the Solana system program
+		{name: "zero public key", data: make([]byte, 32), want: "11111111111111111111111111111111"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := base58Encode(tt.data); got != tt.want {
+				t.Errorf("base58Encode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBase58Decode(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    []byte
+		wantErr error
+	}{
+		{name: "empty", s: "", want: []byte{}},
+		{name: "text", s: "2NEpo7TZRRrLZSi2U", want: []byte("Hello World!")},
+		{name: "leading zeros", s: "11233QC4", want: hexMustDecode("0000287fb4cd")},
+		{name: "zero public key", s: "11111111111111111111111111111111", want: make([]byte, 32)},
+		{name: "character outside the alphabet", s: "2NEpo7TZRRrLZSi2O", wantErr: ErrInvalidBase58},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := base58Decode(tt.s)
+			if err != tt.wantErr {
+				t.Fatalf("base58Decode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("base58Decode() = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package slip10
+
+import (
+	"fmt"
+	"strings"
+)
+
+// bech32 as in https://github.com/bitcoin/bips/blob/master/bip-0173.mediawiki
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+var ErrInvalidHRP = fmt.Errorf("invalid bech32 human-readable part")
+
+var bech32Generator = [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+func bech32Polymod(values []byte) uint32 {
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i, g := range bech32Generator {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= g
+			}
+		}
+	}
+	return chk
+}
+
+func bech32HRPExpand(hrp string) []byte {
+	out := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]>>5)
+	}
+	out = append(out, 0)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]&31)
+	}
+	return out
+}
+
+// bech32Encode encodes data with the lowercase human-readable part hrp.
+// Unlike BIP-173 it does not limit the length of the result, as age does.
+func bech32Encode(hrp string, data []byte) (string, error) {
+	return bech32EncodeValues(hrp, bech32ConvertBits(data))
+}
+
+// bech32EncodeValues encodes 5-bit values with the lowercase human-readable part hrp.
+func bech32EncodeValues(hrp string, values []byte) (string, error) {
+	if hrp == "" || hrp != strings.ToLower(hrp) {
+		return "", ErrInvalidHRP
+	}
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return "", ErrInvalidHRP
+		}
+	}
+
+	checksumInput := append(bech32HRPExpand(hrp), values...)
+	checksumInput = append(checksumInput, 0, 0, 0, 0, 0, 0)
+	polymod := bech32Polymod(checksumInput) ^ 1
+
+	var sb strings.Builder
+	sb.Grow(len(hrp) + 1 + len(values) + 6)
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, v := range values {
+		sb.WriteByte(bech32Charset[v])
+	}
+	for i := 0; i < 6; i++ {
+		sb.WriteByte(bech32Charset[(polymod>>uint(5*(5-i)))&31])
+	}
+	return sb.String(), nil
+}
+
+// bech32ConvertBits regroups 8-bit bytes into 5-bit values, padding the last one with zeroes.
+func bech32ConvertBits(data []byte) []byte {
+	out := make([]byte, 0, (len(data)*8+4)/5)
+	acc, bits := uint32(0), uint(0)
+	for _, b := range data {
+		acc = acc<<8 | uint32(b)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			out = append(out, byte(acc>>bits)&31)
+		}
+	}
+	if bits > 0 {
+		out = append(out, byte(acc<<(5-bits))&31)
+	}
+	return out
+}
@@ -0,0 +1,54 @@
+package slip10
+
+import "testing"
+
+func TestBech32EncodeValues(t *testing.T) {
+	// valid strings from https://github.com/bitcoin/bips/blob/master/bip-0173.mediawiki#test-vectors
+	tests := []struct {
+		name    string
+		hrp     string
+		values  []byte
+		want    string
+		wantErr error
+	}{
+		{
+			name: "empty data",
+			hrp:  "a",
+			want: "a12uel5l",
+		},
+		{
+			name:   "all values",
+			hrp:    "abcdef",
+			values: []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31},
+			want:   "abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw",
+		},
+		{
+			name:   "segwit v0 program",
+			hrp:    "bc",
+			values: append([]byte{0}, bech32ConvertBits(hexMustDecode("751e76e8199196d454941c45d1b3a323f1433bd6"))...),
+			want:   "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		},
+		{
+			name:    "uppercase hrp",
+			hrp:     "BC",
+			wantErr: ErrInvalidHRP,
+		},
+		{
+			name:    "empty hrp",
+			hrp:     "",
+			wantErr: ErrInvalidHRP,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := bech32EncodeValues(tt.hrp, tt.values)
+			if err != tt.wantErr {
+				t.Errorf("bech32EncodeValues() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("bech32EncodeValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package slip10
+
+import (
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const boxKeyInfo = "slip10 box key"
+
+var ErrBoxOpen = fmt.Errorf("box: message authentication failed")
+
+// SealBox encrypts and authenticates plaintext and authenticates associatedData
+// with XChaCha20-Poly1305, under a key derived with HKDF-SHA256 from the node's private key.
+// A random 24-byte nonce is prepended to the returned ciphertext; the extended nonce
+// makes random nonces safe for any practical number of messages.
+// The ciphertext can be opened with OpenBox on the same node.
+func (k *node) SealBox(plaintext, associatedData []byte) (ciphertext []byte, err error) {
+	if k == nil {
+		return nil, ErrNilNode
+	}
+	aead, err := k.boxAEAD()
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, associatedData), nil
+}
+
+// OpenBox decrypts a ciphertext produced by SealBox on the same node.
+// It returns ErrBoxOpen if the ciphertext or the associated data were modified.
+func (k *node) OpenBox(ciphertext, associatedData []byte) (plaintext []byte, err error) {
+	if k == nil {
+		return nil, ErrNilNode
+	}
+	aead, err := k.boxAEAD()
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrBoxOpen
+	}
+
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err = aead.Open(nil, nonce, sealed, associatedData)
+	if err != nil {
+		return nil, ErrBoxOpen
+	}
+	return plaintext, nil
+}
+
+func (k *node) boxAEAD() (cipher.AEAD, error) {
+	key, err := hkdf.Key(sha256.New, k.key, nil, boxKeyInfo, chacha20poly1305.KeySize)
+	if err != nil {
+		return nil, err
+	}
+
+	return chacha20poly1305.NewX(key)
+}
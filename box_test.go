@@ -0,0 +1,82 @@
+package slip10
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNode_SealBox(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	node, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	plaintext := []byte("seed backup")
+	ad := []byte("v1")
+
+	ciphertext, err := node.SealBox(plaintext, ad)
+	if err != nil {
+		t.Fatalf("SealBox() error = %v", err)
+	}
+
+	// the same node derived again opens the box
+	again, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	got, err := again.OpenBox(ciphertext, ad)
+	if err != nil {
+		t.Fatalf("OpenBox() error = %v", err)
+	}
+	if !bytes.Equal(got, plaintext) {
+		t.Errorf("OpenBox() = %q, want %q", got, plaintext)
+	}
+
+	// nonces are random
+	other, err := node.SealBox(plaintext, ad)
+	if err != nil {
+		t.Fatalf("SealBox() error = %v", err)
+	}
+	if bytes.Equal(other, ciphertext) {
+		t.Errorf("SealBox() returned the same ciphertext twice")
+	}
+}
+
+func TestNode_OpenBox_Tampered(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	node, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	otherNode, err := DeriveForPath("m/0'/2'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	ad := []byte("v1")
+
+	ciphertext, err := node.SealBox([]byte("seed backup"), ad)
+	if err != nil {
+		t.Fatalf("SealBox() error = %v", err)
+	}
+	tampered := append([]byte(nil), ciphertext...)
+	tampered[len(tampered)-1] ^= 0x01
+
+	tests := []struct {
+		name       string
+		node       Node
+		ciphertext []byte
+		ad         []byte
+	}{
+		{name: "tampered ciphertext", node: node, ciphertext: tampered, ad: ad},
+		{name: "other associated data", node: node, ciphertext: ciphertext, ad: []byte("v2")},
+		{name: "other node", node: otherNode, ciphertext: ciphertext, ad: ad},
+		{name: "truncated", node: node, ciphertext: ciphertext[:10], ad: ad},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.node.OpenBox(tt.ciphertext, tt.ad); err != ErrBoxOpen {
+				t.Errorf("OpenBox() error = %v, wantErr %v", err, ErrBoxOpen)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package slip10
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	checkpointVersion = 1
+	// version || depth || index || chain code || key
+	checkpointPayloadLen = 1 + 1 + 4 + ChainCodeLen + Ed25519KeyLen
+	checkpointLen        = checkpointPayloadLen + sha256.Size
+	checkpointModifier   = "slip10 checkpoint"
+
+	// MinMACKeyLen is the shortest key accepted for the HMAC of checkpoints and archives.
+	MinMACKeyLen = 16
+)
+
+var (
+	ErrInvalidCheckpoint = fmt.Errorf("invalid checkpoint")
+	ErrInvalidMACKey     = fmt.Errorf("invalid MAC key")
+)
+
+// Checkpoint serializes the node's key, chain code, depth and index into an opaque blob
+// that can be turned back into the node with ResumeFromCheckpoint.
+// The blob contains the private key and must be stored as securely as the seed.
+// It carries an HMAC-SHA256 keyed with macKey, so that ResumeFromCheckpoint rejects blobs
+// modified by anyone who does not hold the key. macKey must be secret and at least
+// MinMACKeyLen bytes long, otherwise ErrInvalidMACKey is returned.
+func (k *node) Checkpoint(macKey []byte) ([]byte, error) {
+	if k == nil {
+		return nil, ErrNilNode
+	}
+	if len(macKey) < MinMACKeyLen {
+		return nil, ErrInvalidMACKey
+	}
+	blob := make([]byte, checkpointPayloadLen, checkpointLen)
+	blob[0] = checkpointVersion
+	blob[1] = k.depth
+	binary.BigEndian.PutUint32(blob[2:6], k.index)
+	copy(blob[6:], k.chainCode)
+	copy(blob[6+ChainCodeLen:], k.key)
+
+	return append(blob, keyedMAC(macKey, checkpointModifier, blob)...), nil
+}
+
+// ResumeFromCheckpoint restores a node serialized with Checkpoint with the same macKey.
+// It returns ErrInvalidCheckpoint if the blob is corrupted or was not made with that key.
+func ResumeFromCheckpoint(blob, macKey []byte) (Node, error) {
+	if len(macKey) < MinMACKeyLen {
+		return nil, ErrInvalidMACKey
+	}
+	if len(blob) != checkpointLen || blob[0] != checkpointVersion {
+		return nil, ErrInvalidCheckpoint
+	}
+
+	payload, mac := blob[:checkpointPayloadLen], blob[checkpointPayloadLen:]
+	if !hmac.Equal(mac, keyedMAC(macKey, checkpointModifier, payload)) {
+		return nil, ErrInvalidCheckpoint
+	}
+
+	key := &node{
+		chainCode: append([]byte(nil), payload[6:6+ChainCodeLen]...),
+		key:       append([]byte(nil), payload[6+ChainCodeLen:]...),
+		depth:     payload[1],
+		index:     binary.BigEndian.Uint32(payload[2:6]),
+	}
+	return key, nil
+}
+
+// keyedMAC returns HMAC-SHA256(macKey, modifier || payload). The modifier separates
+// checkpoints from archives, so that a tag made for one is never valid for the other.
+func keyedMAC(macKey []byte, modifier string, payload []byte) []byte {
+	hash := hmac.New(sha256.New, macKey)
+	hash.Write([]byte(modifier))
+	hash.Write(payload)
+	return hash.Sum(nil)
+}
@@ -0,0 +1,99 @@
+package slip10
+
+import (
+	"bytes"
+	"testing"
+)
+
+// testMACKey is the HMAC key of checkpoints and archives in tests.
+var testMACKey = []byte("0123456789abcdef0123456789abcdef")
+
+func TestResumeFromCheckpoint(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	node, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	blob, err := node.Checkpoint(testMACKey)
+	if err != nil {
+		t.Fatalf("Checkpoint() error = %v", err)
+	}
+
+	got, err := ResumeFromCheckpoint(blob, testMACKey)
+	if err != nil {
+		t.Fatalf("ResumeFromCheckpoint() error = %v", err)
+	}
+	if !bytes.Equal(got.PrivateKey(), node.PrivateKey()) {
+		t.Errorf("PrivateKey() = %X, want %X", got.PrivateKey(), node.PrivateKey())
+	}
+
+	// resumed nodes keep deriving the same children
+	child, err := got.Derive(FirstHardenedIndex + 2)
+	if err != nil {
+		t.Fatalf("Derive() error = %v", err)
+	}
+	want := hexMustDecode("92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9")
+	if !bytes.Equal(child.PrivateKey(), want) {
+		t.Errorf("PrivateKey() = %X, want %X", child.PrivateKey(), want)
+	}
+}
+
+func TestResumeFromCheckpoint_Invalid(t *testing.T) {
+	node, err := NewMasterNode(hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("NewMasterNode() error = %v", err)
+	}
+	blob, err := node.Checkpoint(testMACKey)
+	if err != nil {
+		t.Fatalf("Checkpoint() error = %v", err)
+	}
+
+	tampered := append([]byte(nil), blob...)
+	tampered[40] ^= 0x01
+
+	badVersion := append([]byte(nil), blob...)
+	badVersion[0] = 0xff
+
+	tests := []struct {
+		name string
+		blob []byte
+	}{
+		{name: "empty", blob: nil},
+		{name: "truncated", blob: blob[:len(blob)-1]},
+		{name: "tampered", blob: tampered},
+		{name: "unknown version", blob: badVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ResumeFromCheckpoint(tt.blob, testMACKey); err != ErrInvalidCheckpoint {
+				t.Errorf("ResumeFromCheckpoint() error = %v, want %v", err, ErrInvalidCheckpoint)
+			}
+		})
+	}
+
+	// a blob rewritten and re-tagged without the key is rejected
+	forged, err := node.Checkpoint([]byte("an attacker's own sixteen+ byte key"))
+	if err != nil {
+		t.Fatalf("Checkpoint() error = %v", err)
+	}
+	if _, err := ResumeFromCheckpoint(forged, testMACKey); err != ErrInvalidCheckpoint {
+		t.Errorf("ResumeFromCheckpoint() of a blob with another key error = %v, want %v", err, ErrInvalidCheckpoint)
+	}
+}
+
+func TestCheckpoint_InvalidMACKey(t *testing.T) {
+	node, err := NewMasterNode(hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("NewMasterNode() error = %v", err)
+	}
+
+	for _, macKey := range [][]byte{nil, make([]byte, MinMACKeyLen-1)} {
+		if _, err := node.Checkpoint(macKey); err != ErrInvalidMACKey {
+			t.Errorf("Checkpoint() with a %d-byte key error = %v, want %v", len(macKey), err, ErrInvalidMACKey)
+		}
+		if _, err := ResumeFromCheckpoint(make([]byte, checkpointLen), macKey); err != ErrInvalidMACKey {
+			t.Errorf("ResumeFromCheckpoint() with a %d-byte key error = %v, want %v", len(macKey), err, ErrInvalidMACKey)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package slip10
+
+// COSE_Key labels and values, https://www.rfc-editor.org/rfc/rfc9053#section-7.2
+const (
+	coseKeyLabelKty = 1
+	coseKeyLabelAlg = 3
+	coseKeyLabelCrv = -1
+	coseKeyLabelX   = -2
+
+	coseKtyOKP     = 1
+	coseAlgEdDSA   = -8
+	coseCrvEd25519 = 6
+)
+
+// COSEKey returns the node's public key as a CBOR encoded COSE_Key (RFC 9052)
+// with kty OKP, alg EdDSA, crv Ed25519 and the public key as x,
+// the form used for credential public keys in WebAuthn and FIDO2.
+// The map is encoded deterministically (RFC 8949, section 4.2), so the same node
+// always gives the same bytes. The key carries no kid or key_ops, and the library
+// implements none of the attestation or authenticator side of WebAuthn.
+// It returns ErrInvalidPrivateKey for nodes without a 32-byte private key.
+func (k *node) COSEKey() ([]byte, error) {
+	pub, _, err := k.keypair()
+	if err != nil {
+		return nil, err
+	}
+
+	// keys are sorted by their encoding: 1, 3, -1, -2
+	buf := make([]byte, 0, 11+Ed25519KeyLen)
+	buf = append(buf, 0xa4) // map of 4 pairs
+	buf = cborAppendInt(buf, coseKeyLabelKty)
+	buf = cborAppendInt(buf, coseKtyOKP)
+	buf = cborAppendInt(buf, coseKeyLabelAlg)
+	buf = cborAppendInt(buf, coseAlgEdDSA)
+	buf = cborAppendInt(buf, coseKeyLabelCrv)
+	buf = cborAppendInt(buf, coseCrvEd25519)
+	buf = cborAppendInt(buf, coseKeyLabelX)
+	buf = append(buf, 0x58, Ed25519KeyLen) // byte string, one byte length
+	buf = append(buf, pub...)
+	return buf, nil
+}
+
+// cborAppendInt appends a CBOR integer in -24..23, which fits in the initial byte.
+func cborAppendInt(buf []byte, v int) []byte {
+	if v >= 0 {
+		return append(buf, byte(v))
+	}
+	return append(buf, 0x20|byte(-1-v))
+}
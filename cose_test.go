@@ -0,0 +1,122 @@
+package slip10
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func TestNode_COSEKey(t *testing.T) {
+	// map(4) {1: 1 (kty OKP), 3: -8 (alg EdDSA), -1: 6 (crv Ed25519), -2: bstr(32) (x)}
+	tests := []struct {
+		name string
+		path string
+		want []byte
+	}{
+		{
+			name: "m/0'/1'",
+			path: "m/0'/1'",
+			want: hexMustDecode("a4" + "0101" + "0327" + "2006" + "215820" + "1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"),
+		},
+		{
+			name: "m",
+			path: "m",
+			want: hexMustDecode("a4" + "0101" + "0327" + "2006" + "215820" + "a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := DeriveForPath(tt.path, hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+
+			got, err := node.COSEKey()
+			if err != nil {
+				t.Fatalf("COSEKey() error = %v", err)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("COSEKey() = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNode_COSEKey_Decode(t *testing.T) {
+	node, err := DeriveForPath("m/0'/1'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	encoded, err := node.COSEKey()
+	if err != nil {
+		t.Fatalf("COSEKey() error = %v", err)
+	}
+
+	ints, x, err := decodeCOSEKey(encoded)
+	if err != nil {
+		t.Fatalf("decodeCOSEKey() error = %v", err)
+	}
+	if ints[coseKeyLabelKty] != coseKtyOKP {
+		t.Errorf("kty = %d, want %d", ints[coseKeyLabelKty], coseKtyOKP)
+	}
+	if ints[coseKeyLabelAlg] != coseAlgEdDSA {
+		t.Errorf("alg = %d, want %d", ints[coseKeyLabelAlg], coseAlgEdDSA)
+	}
+	if ints[coseKeyLabelCrv] != coseCrvEd25519 {
+		t.Errorf("crv = %d, want %d", ints[coseKeyLabelCrv], coseCrvEd25519)
+	}
+	pub, _ := node.Keypair()
+	if !bytes.Equal(x, pub) {
+		t.Errorf("x = %x, want %x", x, pub)
+	}
+}
+
+// decodeCOSEKey parses a CBOR map with small integer labels whose values are
+// small integers, except for the x label, which holds a byte string.
+func decodeCOSEKey(b []byte) (ints map[int]int, x []byte, err error) {
+	readInt := func() (int, error) {
+		if len(b) == 0 {
+			return 0, fmt.Errorf("unexpected end of data")
+		}
+		head := b[0]
+		b = b[1:]
+		switch {
+		case head < 0x18:
+			return int(head), nil
+		case head >= 0x20 && head < 0x38:
+			return -1 - int(head&0x1f), nil
+		}
+		return 0, fmt.Errorf("unexpected initial byte %#x", head)
+	}
+
+	if len(b) == 0 || b[0]&0xe0 != 0xa0 || b[0]&0x1f >= 0x18 {
+		return nil, nil, fmt.Errorf("not a short map")
+	}
+	pairs := int(b[0] & 0x1f)
+	b = b[1:]
+
+	ints = make(map[int]int, pairs)
+	for range pairs {
+		label, err := readInt()
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, ok := ints[label]; ok || (label == coseKeyLabelX && x != nil) {
+			return nil, nil, fmt.Errorf("duplicate label %d", label)
+		}
+		if label != coseKeyLabelX {
+			if ints[label], err = readInt(); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		if len(b) < 2 || b[0] != 0x58 || len(b) < 2+int(b[1]) {
+			return nil, nil, fmt.Errorf("x is not a byte string")
+		}
+		x, b = b[2:2+int(b[1])], b[2+int(b[1]):]
+	}
+	if len(b) != 0 {
+		return nil, nil, fmt.Errorf("trailing data")
+	}
+	return ints, x, nil
+}
@@ -0,0 +1,20 @@
+package slip10
+
+import "crypto/sha256"
+
+// cosmosAddressLen is the length of a Cosmos address: SHA-256 of the public key truncated to 20 bytes.
+const cosmosAddressLen = 20
+
+// Bech32Address returns the Cosmos SDK address of the node's ed25519 public key,
+// bech32 encoded with the human-readable part hrp, e.g. "cosmos" or "cosmosvalcons".
+// As in the Cosmos SDK and CometBFT, the address of an ed25519 key is the first 20 bytes
+// of SHA-256 of the bare 32-byte public key; RIPEMD-160(SHA-256) is used for secp256k1 keys only.
+// It returns ErrInvalidPrivateKey for nodes without a 32-byte private key.
+func (k *node) Bech32Address(hrp string) (string, error) {
+	pub, _, err := k.keypair()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(pub)
+	return bech32Encode(hrp, sum[:cosmosAddressLen])
+}
@@ -0,0 +1,36 @@
+package slip10
+
+import "testing"
+
+func TestNode_Bech32Address(t *testing.T) {
+	// computed independently with the BIP-173 reference encoder over sha256(pub)[:20]
+	tests := []struct {
+		name    string
+		path    string
+		hrp     string
+		want    string
+		wantErr error
+	}{
+		{name: "master account", path: "m", hrp: "cosmos", want: "cosmos1x3y6nuycpaa05tcx2vcuapsgwwgmncu6shk0fw"},
+		{name: "master consensus", path: "m", hrp: "cosmosvalcons", want: "cosmosvalcons1x3y6nuycpaa05tcx2vcuapsgwwgmncu6ps3xfu"},
+		{name: "derived account", path: "m/0'/1'", hrp: "cosmos", want: "cosmos149vt30fujarufxmpzdz2nsnhvxmpghhcnzsn4w"},
+		{name: "uppercase hrp", path: "m", hrp: "COSMOS", wantErr: ErrInvalidHRP},
+		{name: "empty hrp", path: "m", hrp: "", wantErr: ErrInvalidHRP},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := DeriveForPath(tt.path, hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+
+			got, err := node.Bech32Address(tt.hrp)
+			if err != tt.wantErr {
+				t.Fatalf("Bech32Address() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Bech32Address() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package slip10
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Curve is a curve keys can be derived on.
+type Curve int
+
+const (
+	// CurveEd25519 is ed25519, the only curve of this package, with hardened derivation only.
+	CurveEd25519 Curve = iota
+)
+
+var ErrUnknownCurve = fmt.Errorf("unknown curve")
+
+// String returns the name of the curve, e.g. "ed25519".
+func (c Curve) String() string {
+	switch c {
+	case CurveEd25519:
+		return "ed25519"
+	default:
+		return fmt.Sprintf("Curve(%d)", int(c))
+	}
+}
+
+// parseCurve returns the curve with the name returned by String.
+func parseCurve(name string) (Curve, error) {
+	switch name {
+	case CurveEd25519.String():
+		return CurveEd25519, nil
+	default:
+		return 0, ErrUnknownCurve
+	}
+}
+
+// ValidatePathForCurve checks up front that a path can be derived on the curve.
+// The path must be in the format of DeriveForPath, with apostrophes as the only hardened markers;
+// ed25519 has no public derivation, so a segment without an apostrophe returns
+// ErrNoPublicDerivation wrapped with the segment.
+func ValidatePathForCurve(path string, curve Curve) error {
+	if curve != CurveEd25519 {
+		return ErrUnknownCurve
+	}
+
+	if pathDepth(path) > MaxPathDepth {
+		return ErrPathTooDeep
+	}
+	// the grammar of DeriveForPath, but with the apostrophe optional to report unhardened segments
+	if !rawPathRegex.MatchString(path) {
+		return ErrInvalidPath
+	}
+	for n, segment := range strings.Split(path, "/")[1:] {
+		i, err := strconv.ParseUint(strings.TrimSuffix(segment, "'"), 10, 31)
+		if err != nil {
+			return ErrInvalidPath
+		}
+		if !strings.HasSuffix(segment, "'") {
+			return fmt.Errorf("%w: segment %d (%d) is not hardened", ErrNoPublicDerivation, n+1, i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package slip10
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidatePathForCurve(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		curve   Curve
+		wantErr error
+		wantMsg string
+	}{
+		{name: "hardened", path: "m/44'/501'/0'", curve: CurveEd25519},
+		{name: "other hardened notation", path: "m/44h/501H/0'", curve: CurveEd25519, wantErr: ErrInvalidPath},
+		{name: "no master", path: "44h/0h", curve: CurveEd25519, wantErr: ErrInvalidPath},
+		{name: "upper-case marker", path: "m/44H/0h", curve: CurveEd25519, wantErr: ErrInvalidPath},
+		{name: "surrounding spaces", path: " m/0'/ ", curve: CurveEd25519, wantErr: ErrInvalidPath},
+		{name: "index out of range", path: "m/2147483648'", curve: CurveEd25519, wantErr: ErrInvalidPath},
+		{name: "master", path: "m", curve: CurveEd25519},
+		{
+			name:    "non-hardened segment",
+			path:    "m/44'/501'/0'/0",
+			curve:   CurveEd25519,
+			wantErr: ErrNoPublicDerivation,
+			wantMsg: "no public derivation for ed25519: segment 4 (0) is not hardened",
+		},
+		{name: "invalid path", path: "m/44'/x", curve: CurveEd25519, wantErr: ErrInvalidPath},
+		{name: "unknown curve", path: "m/44'", curve: Curve(42), wantErr: ErrUnknownCurve},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePathForCurve(tt.path, tt.curve)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidatePathForCurve() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantMsg != "" && err.Error() != tt.wantMsg {
+				t.Errorf("ValidatePathForCurve() error = %q, want %q", err, tt.wantMsg)
+			}
+		})
+	}
+}
@@ -1,7 +1,7 @@
 package slip10
 
 import (
-	"bytes"
+	"crypto"
 	"crypto/ed25519"
 	"crypto/hmac"
 	"crypto/sha512"
@@ -18,32 +18,151 @@ const (
 	FirstHardenedIndex = uint32(0x80000000)
 	// As in https://github.com/satoshilabs/slips/blob/master/slip-0010.md
 	seedModifier = "ed25519 seed"
+
+	// Ed25519KeyLen is the length of ed25519 private key seeds and public keys.
+	Ed25519KeyLen = 32
+	// Ed25519PrefixedPubKeyLen is the length of public keys returned by PublicKeyWithPrefix.
+	Ed25519PrefixedPubKeyLen = Ed25519KeyLen + 1
+	// ChainCodeLen is the length of a node's chain code.
+	ChainCodeLen = 32
+
+	// MaxPathDepth is the maximum number of segments in a path. BIP-32 serializes the depth as a single byte.
+	MaxPathDepth = 255
 )
 
 var (
 	ErrInvalidPath        = fmt.Errorf("invalid derivation path")
 	ErrNoPublicDerivation = fmt.Errorf("no public derivation for ed25519")
+	ErrPathTooDeep        = fmt.Errorf("derivation path is too deep")
+	ErrCannotDerive       = fmt.Errorf("cannot derive from node")
+	ErrNilNode            = fmt.Errorf("nil node")
+	ErrInvalidDomain      = fmt.Errorf("invalid domain")
 
-	pathRegex = regexp.MustCompile("^m(/[0-9]+')*$")
+	pathRegex    = regexp.MustCompile("^m(/[0-9]+')*$")
+	rawPathRegex = regexp.MustCompile("^m(/[0-9]+'?)*$")
 )
 
+// Node is a SLIP-0010 ed25519 node. Its methods do not panic on a nil node:
+// those that return an error return ErrNilNode, wrapped in ErrCannotDerive by the
+// derivation methods, and the others return zero values. SigningFunc is the exception,
+// its sign function returns nil as it does for any node without a valid key.
 type Node interface {
 	Derive(i uint32) (Node, error)
+	DeriveChild(childNumber uint32, hardened bool) (Node, error)
+	SupportsPublicDerivation() bool
+	NextAddress() (Node, error)
+	AncestorAt(depth int) (Node, error)
+	DeriveShard(start, end uint32, hardened bool, fn func(index uint32, node Node) error) error
 
 	Keypair() (ed25519.PublicKey, ed25519.PrivateKey)
+	LibsodiumKeypair() (pk [32]byte, sk [64]byte)
+	SigningFunc() (sign func(msg []byte) []byte, cleanup func())
+	Signer() crypto.Signer
+	SignSplit(message []byte) (r, s [32]byte, err error)
+	RequireMinDepth(minDepth int) error
+	SignBatch(messages [][]byte) ([][]byte, error)
+	SignJWT(claims map[string]any) (string, error)
+	SignStatement(statement string, domain string, nonce string) (signature []byte, signedMessage string, err error)
+	Checkpoint(macKey []byte) ([]byte, error)
+	Subtree() (key, chainCode []byte)
+	UUID() string
+	SolanaKeypairJSON() ([]byte, error)
+	Fingerprint() [4]byte
+	Identicon() string
+	AccountLabel(coinSymbol string) (string, error)
+	VerificationWord() string
+	MasterFingerprint() ([4]byte, error)
+	Path() (string, error)
+	KeyOriginJSON() ([]byte, error)
+	DerivationProof() (DerivationProof, error)
+	ToKDBXFields() map[string]string
+	VRFProve(alpha []byte) (output, proof []byte, err error)
+	SealBox(plaintext, associatedData []byte) (ciphertext []byte, err error)
+	OpenBox(ciphertext, associatedData []byte) (plaintext []byte, err error)
+	X25519PrivateKey() []byte
+	X25519PublicKey() ([]byte, error)
+	WireGuardKeys() (privateBase64, publicBase64 string, err error)
+	AgeX25519Identity() (string, error)
+	AgeRecipient() (string, error)
+	DIDKey() (string, error)
+	Bech32Address(hrp string) (string, error)
+	GitSigningKey(comment string) (privatePEM []byte, allowedSigner string, err error)
+	COSEKey() ([]byte, error)
+	DeriveToken(label string, n int) (string, error)
+	DeterministicShuffle(n int, seed []byte) ([]int, error)
+	DeriveNonce(context []byte, counter uint64) ([]byte, error)
+	RecoveryCode(groups, groupLen int) (string, error)
 	PrivateKey() []byte
 	PublicKeyWithPrefix() []byte
+	PublicKeyEncodings() PublicKeyEncodings
+	SamePublic(other Node) bool
 	RawSeed() []byte
+	HMACOutput() []byte
 }
 
 type node struct {
 	chainCode []byte
 	key       []byte
+
+	depth uint8
+	index uint32
+
+	// origin is known only for nodes derived from a seed, not for restored ones
+	hasOrigin         bool
+	masterFingerprint [4]byte
+	path              []uint32
+
+	// ancestors holds copies of the nodes from the master node to the parent, indexed by depth,
+	// for nodes derived WithAncestry; it is nil for other nodes and empty for the master node
+	ancestors []*node
 }
 
 // DeriveForPath derives key for a path in BIP-44 format and a seed.
-// Ed25119 derivation operated on hardened keys only.
-func DeriveForPath(path string, seed []byte) (Node, error) {
+// Ed25119 derivation operated on hardened keys only, so every segment must be
+// marked with an apostrophe and FirstHardenedIndex is added to its value:
+// "m/0'" derives the child with index 2^31. Use DeriveForPathRaw to write raw indices.
+// Options such as WithReservedPaths add checks; without them the behavior is unchanged.
+func DeriveForPath(path string, seed []byte, opts ...DeriveOption) (Node, error) {
+	o, err := applyDeriveOptions(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	if !o.ancestry {
+		return walkPath(path, seed, nil)
+	}
+
+	ancestors := make([]*node, 0, pathDepth(path)+1)
+	key, err := walkPath(path, seed, func(n Node) {
+		ancestors = append(ancestors, n.(*node).detached())
+	})
+	if err != nil {
+		return nil, err
+	}
+	key.(*node).ancestors = ancestors[:len(ancestors)-1]
+	return key, nil
+}
+
+// DerivePathNodes derives key for a path like DeriveForPath does and returns every node
+// on the way, from the master node to the final node, e.g. to warm a cache shared by sibling paths.
+// The result has one more element than the path has segments.
+func DerivePathNodes(seed []byte, path string) ([]Node, error) {
+	nodes := make([]Node, 0, pathDepth(path)+1)
+	_, err := walkPath(path, seed, func(n Node) {
+		nodes = append(nodes, n)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+// walkPath derives key for a path like DeriveForPath does,
+// calling visit, if not nil, with the master node and every node derived on the way.
+func walkPath(path string, seed []byte, visit func(Node)) (Node, error) {
+	if pathDepth(path) > MaxPathDepth {
+		return nil, ErrPathTooDeep
+	}
 	if !IsValidPath(path) {
 		return nil, ErrInvalidPath
 	}
@@ -52,10 +171,13 @@ func DeriveForPath(path string, seed []byte) (Node, error) {
 	if err != nil {
 		return nil, err
 	}
+	if visit != nil {
+		visit(key)
+	}
 
 	segments := strings.Split(path, "/")
 	for _, segment := range segments[1:] {
-		i64, err := strconv.ParseUint(strings.TrimRight(segment, "'"), 10, 32)
+		i64, err := strconv.ParseUint(strings.TrimRight(segment, "'"), 10, 31)
 		if err != nil {
 			return nil, err
 		}
@@ -66,32 +188,204 @@ func DeriveForPath(path string, seed []byte) (Node, error) {
 		if err != nil {
 			return nil, err
 		}
+		if visit != nil {
+			visit(key)
+		}
 	}
 
 	return key, nil
 }
 
-// NewMasterNode generates a new master key from seed.
-func NewMasterNode(seed []byte) (Node, error) {
-	hash := hmac.New(sha512.New, []byte(seedModifier))
-	_, err := hash.Write(seed)
+// DeriveForPathRaw derives key for a path where indices can be written either as
+// hardened child numbers or as raw indices. A segment with an apostrophe, e.g. "0'",
+// is a child number below 2^31 and gets FirstHardenedIndex added as in DeriveForPath.
+// A segment without an apostrophe, e.g. "2147483648", is used as the index as-is,
+// so "m/2147483648" and "m/0'" derive the same key.
+// Ed25519 derivation operates on hardened keys only, so raw indices below
+// FirstHardenedIndex fail with ErrNoPublicDerivation.
+func DeriveForPathRaw(path string, seed []byte) (Node, error) {
+	if pathDepth(path) > MaxPathDepth {
+		return nil, ErrPathTooDeep
+	}
+	if !rawPathRegex.MatchString(path) {
+		return nil, ErrInvalidPath
+	}
+
+	key, err := NewMasterNode(seed)
 	if err != nil {
 		return nil, err
 	}
+
+	segments := strings.Split(path, "/")
+	for _, segment := range segments[1:] {
+		hardened := strings.HasSuffix(segment, "'")
+
+		i64, err := strconv.ParseUint(strings.TrimRight(segment, "'"), 10, 32)
+		if err != nil {
+			return nil, ErrInvalidPath
+		}
+
+		i := uint32(i64)
+		if hardened {
+			if i >= FirstHardenedIndex {
+				return nil, ErrInvalidPath
+			}
+			i += FirstHardenedIndex
+		}
+
+		key, err = key.Derive(i)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+// deriveIndices derives key for indices that already include the hardened offset.
+func deriveIndices(seed []byte, indices []uint32) (Node, error) {
+	key, err := NewMasterNode(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return deriveFrom(key, indices)
+}
+
+// deriveFrom derives the descendant of key for indices that already include the hardened offset.
+func deriveFrom(key Node, indices []uint32) (Node, error) {
+	var err error
+	for _, i := range indices {
+		key, err = key.Derive(i)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+// NewMasterNode generates a new master key from seed.
+// It returns ErrInvalidSeedLength for a nil or empty seed, which is always an integration mistake.
+func NewMasterNode(seed []byte) (Node, error) {
+	return newMasterNode(seedModifier, seed)
+}
+
+// NewMasterNodeFromChunks generates a new master key from a seed split into chunks,
+// as NewMasterNode does for their concatenation. The chunks are hashed in order without
+// being copied into a combined buffer, so there is no extra copy of the seed to wipe.
+// The total length must be between MinSeedLength and MaxSeedLength, otherwise ErrInvalidSeedLength is returned.
+func NewMasterNodeFromChunks(chunks ...[]byte) (Node, error) {
+	total := 0
+	for _, chunk := range chunks {
+		total += len(chunk)
+	}
+	if total < MinSeedLength || total > MaxSeedLength {
+		return nil, ErrInvalidSeedLength
+	}
+
+	return newMasterNode(seedModifier, chunks...)
+}
+
+// NewMasterNodeDomain generates a new master key from seed like NewMasterNode does,
+// but with domain as the HMAC key instead of "ed25519 seed", so that every domain
+// gets a separate tree of keys from the same seed.
+//
+// This is NOT slip-10: no other slip-10 implementation or wallet derives the same keys,
+// so the keys can only be recovered with this function and the exact domain.
+// Use it for domain separation inside an application only.
+func NewMasterNodeDomain(seed []byte, domain string) (Node, error) {
+	if domain == "" {
+		return nil, ErrInvalidDomain
+	}
+
+	return newMasterNode(domain, seed)
+}
+
+func newMasterNode(modifier string, chunks ...[]byte) (Node, error) {
+	hash := hmac.New(sha512.New, []byte(modifier))
+	total := 0
+	for _, chunk := range chunks {
+		if _, err := hash.Write(chunk); err != nil {
+			return nil, err
+		}
+		total += len(chunk)
+	}
+	if total == 0 {
+		return nil, ErrInvalidSeedLength
+	}
 	sum := hash.Sum(nil)
 	key := &node{
-		key:       sum[:32],
-		chainCode: sum[32:],
+		key:       sum[:Ed25519KeyLen],
+		chainCode: sum[Ed25519KeyLen:],
+		hasOrigin: true,
 	}
+	key.masterFingerprint = key.Fingerprint()
 	return key, nil
 }
 
+// Derive derives the child with the raw index i, which already includes the hardened offset:
+// child number 0' is FirstHardenedIndex and indices below it return ErrNoPublicDerivation.
+// Adding FirstHardenedIndex to an index that already has it wraps around to a non-hardened index,
+// so callers working with child numbers should use DeriveChild instead.
 func (k *node) Derive(i uint32) (Node, error) {
+	if k == nil {
+		return nil, fmt.Errorf("%w: %w", ErrCannotDerive, ErrNilNode)
+	}
 	// no public derivation for ed25519
 	if i < FirstHardenedIndex {
 		return nil, ErrNoPublicDerivation
 	}
+	// ed25519 derivation is always hardened and needs both halves of the node
+	if len(k.chainCode) != ChainCodeLen {
+		return nil, fmt.Errorf("%w: node has no chain code", ErrCannotDerive)
+	}
+	if len(k.key) != Ed25519KeyLen {
+		return nil, fmt.Errorf("%w: hardened derivation needs the private key", ErrCannotDerive)
+	}
+
+	sum, err := k.childHMAC(i)
+	if err != nil {
+		return nil, err
+	}
+	newKey := &node{
+		key:       sum[:Ed25519KeyLen],
+		chainCode: sum[Ed25519KeyLen:],
+		depth:     k.depth + 1,
+		index:     i,
+	}
+	if k.ancestors != nil {
+		newKey.ancestors = append(append(make([]*node, 0, len(k.ancestors)+1), k.ancestors...), k.detached())
+	}
+	if k.hasOrigin {
+		newKey.hasOrigin = true
+		newKey.masterFingerprint = k.masterFingerprint
+		newKey.path = append(append(make([]uint32, 0, len(k.path)+1), k.path...), i)
+	}
+	return newKey, nil
+}
 
+// DeriveChild derives the child with the child number, adding FirstHardenedIndex itself
+// for a hardened child: DeriveChild(0, true) derives 0'. The child number must be below 2^31,
+// so a raw index that already has the hardened bit set returns ErrInvalidIndex instead of
+// deriving another key, and hardened must be true since ed25519 has no public derivation.
+func (k *node) DeriveChild(childNumber uint32, hardened bool) (Node, error) {
+	if k == nil {
+		return nil, fmt.Errorf("%w: %w", ErrCannotDerive, ErrNilNode)
+	}
+	if childNumber >= FirstHardenedIndex {
+		return nil, fmt.Errorf("%w: child number %d already has the hardened bit set", ErrInvalidIndex, childNumber)
+	}
+	if !hardened {
+		return nil, ErrNoPublicDerivation
+	}
+
+	return k.Derive(childNumber + FirstHardenedIndex)
+}
+
+// childHMAC returns HMAC-SHA512(chain code, 0x00 || key || i), the key and chain code
+// of the hardened child i, without checking i.
+func (k *node) childHMAC(i uint32) ([]byte, error) {
 	iBytes := make([]byte, 4)
 	binary.BigEndian.PutUint32(iBytes, i)
 	key := append([]byte{0x0}, k.key...)
@@ -102,54 +396,108 @@ func (k *node) Derive(i uint32) (Node, error) {
 	if err != nil {
 		return nil, err
 	}
-	sum := hash.Sum(nil)
-	newKey := &node{
-		key:       sum[:32],
-		chainCode: sum[32:],
-	}
-	return newKey, nil
+	return hash.Sum(nil), nil
+}
+
+// SupportsPublicDerivation reports whether Derive accepts non-hardened indices.
+// It is always false: there is no public derivation for ed25519, see ErrNoPublicDerivation.
+func (k *node) SupportsPublicDerivation() bool {
+	return false
 }
 
-// PrivateKey returns private key for a derived private key.
+// Keypair returns the ed25519 keypair of the node, with the node key as the private key seed.
+// NewMasterNode, Derive and ResumeFromCheckpoint always produce 32-byte keys;
+// for a key of any other length Keypair returns nil slices.
 func (k *node) Keypair() (ed25519.PublicKey, ed25519.PrivateKey) {
-	reader := bytes.NewReader(k.key)
-	pub, priv, err := ed25519.GenerateKey(reader)
-	if err != nil {
-		// can't happens because we check the seed on NewMasterNode/DeriveForPath
-		return nil, nil
+	pub, priv, _ := k.keypair()
+	return pub, priv
+}
+
+// keypair is Keypair for methods that must not go on with nil slices:
+// it returns ErrInvalidPrivateKey for a key that is not 32 bytes long.
+func (k *node) keypair() (ed25519.PublicKey, ed25519.PrivateKey, error) {
+	if k == nil {
+		return nil, nil, ErrNilNode
+	}
+	if len(k.key) != ed25519.SeedSize {
+		return nil, nil, ErrInvalidPrivateKey
 	}
 
-	return pub[:], priv[:]
+	priv := ed25519.NewKeyFromSeed(k.key)
+	return priv.Public().(ed25519.PublicKey), priv, nil
+}
+
+// LibsodiumKeypair returns the keypair in the layout of libsodium's crypto_sign_seed_keypair:
+// the 32-byte public key and the 64-byte secret key (seed || public key).
+func (k *node) LibsodiumKeypair() (pk [32]byte, sk [64]byte) {
+	if k == nil {
+		return pk, sk
+	}
+	pub, priv := k.Keypair()
+	copy(pk[:], pub)
+	copy(sk[:], priv)
+	return pk, sk
 }
 
 // RawSeed returns raw seed bytes
 func (k *node) RawSeed() []byte {
+	if k == nil {
+		return nil
+	}
 	return k.key
 }
 
+// HMACOutput returns a copy of the 64-byte HMAC-SHA512 output I = IL || IR that produced the node,
+// i.e. its private key followed by its chain code, to compare against other slip-10 implementations.
+// It contains the private key and must be handled as such.
+func (k *node) HMACOutput() []byte {
+	if k == nil {
+		return nil
+	}
+	return append(append(make([]byte, 0, len(k.key)+len(k.chainCode)), k.key...), k.chainCode...)
+}
+
 // PrivateKey returns private key seed bytes
 func (k *node) PrivateKey() []byte {
 	_, priv := k.Keypair()
+	if priv == nil {
+		return nil
+	}
 	return priv.Seed()
 }
 
 // PublicKeyWithPrefix returns public key with 0x00 prefix, as specified in the slip-10
 // https://github.com/satoshilabs/slips/blob/master/slip-0010/testvectors.py#L64
 func (k *node) PublicKeyWithPrefix() []byte {
+	if k == nil {
+		return nil
+	}
 	pub, _ := k.Keypair()
-	return append([]byte{0x00}, pub...)
+	return AddPublicPrefix(pub)
 }
 
-// IsValidPath check whether or not the path has valid segments.
+// IsValidPath check whether or not the path has valid segments
+// and no more than MaxPathDepth of them.
 func IsValidPath(path string) bool {
+	return IsValidPathWithDepth(path, MaxPathDepth)
+}
+
+// IsValidPathWithDepth is IsValidPath with a custom limit on the number of segments.
+// A non-positive maxDepth disables the limit.
+func IsValidPathWithDepth(path string, maxDepth int) bool {
+	// count the segments first, so that huge paths are rejected without running the regex
+	if maxDepth > 0 && pathDepth(path) > maxDepth {
+		return false
+	}
+
 	if !pathRegex.MatchString(path) {
 		return false
 	}
 
-	// check for overflows
+	// check for overflows, child numbers must leave room for the hardened offset
 	segments := strings.Split(path, "/")
 	for _, segment := range segments[1:] {
-		_, err := strconv.ParseUint(strings.TrimRight(segment, "'"), 10, 32)
+		_, err := strconv.ParseUint(strings.TrimRight(segment, "'"), 10, 31)
 		if err != nil {
 			return false
 		}
@@ -157,3 +505,7 @@ func IsValidPath(path string) bool {
 
 	return true
 }
+
+func pathDepth(path string) int {
+	return strings.Count(path, "/")
+}
@@ -2,8 +2,11 @@ package slip10
 
 import (
 	"bytes"
+	"crypto/ed25519"
 	"encoding/hex"
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -276,3 +279,647 @@ func TestNewMasterNode(t *testing.T) {
 		})
 	}
 }
+
+func TestNode_Keypair_Golden(t *testing.T) {
+	// pinned bytes, so a change in the stdlib or in Keypair that alters keys is caught
+	tests := []struct {
+		name     string
+		path     string
+		wantPub  []byte
+		wantPriv []byte
+	}{
+		{
+			name:    "Key(m/0')",
+			path:    "m/0'",
+			wantPub: hexMustDecode("8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c"),
+			wantPriv: hexMustDecode("68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3" +
+				"8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c"),
+		},
+		{
+			name:    "Key(m/0'/1'/2'/2'/1000000000')",
+			path:    "m/0'/1'/2'/2'/1000000000'",
+			wantPub: hexMustDecode("3c24da049451555d51a7014a37337aa4e12d41e485abccfa46b47dfb2af54b7a"),
+			wantPriv: hexMustDecode("8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793" +
+				"3c24da049451555d51a7014a37337aa4e12d41e485abccfa46b47dfb2af54b7a"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := DeriveForPath(tt.path, hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+
+			pub, priv := node.Keypair()
+			if !bytes.Equal(pub, tt.wantPub) {
+				t.Errorf("Keypair() pub = %x, want %x", pub, tt.wantPub)
+			}
+			if !bytes.Equal(priv, tt.wantPriv) {
+				t.Errorf("Keypair() priv = %x, want %x", priv, tt.wantPriv)
+			}
+
+			// Keypair used to feed the key to GenerateKey as its random source
+			genPub, genPriv, err := ed25519.GenerateKey(bytes.NewReader(node.RawSeed()))
+			if err != nil {
+				t.Fatalf("GenerateKey() error = %v", err)
+			}
+			if !bytes.Equal(pub, genPub) || !bytes.Equal(priv, genPriv) {
+				t.Errorf("Keypair() = %x, %x, GenerateKey() = %x, %x", pub, priv, genPub, genPriv)
+			}
+		})
+	}
+}
+
+func TestNode_Keypair_ShortKey(t *testing.T) {
+	pub, priv := (&node{key: make([]byte, 31)}).Keypair()
+	if pub != nil || priv != nil {
+		t.Errorf("Keypair() = %x, %x, want nil slices", pub, priv)
+	}
+}
+
+func TestNode_ShortKey(t *testing.T) {
+	k := &node{key: make([]byte, 31), chainCode: make([]byte, 32), hasOrigin: true}
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{name: "SolanaKeypairJSON", call: func() error { _, err := k.SolanaKeypairJSON(); return err }},
+		{name: "KeyOriginJSON", call: func() error { _, err := k.KeyOriginJSON(); return err }},
+		{name: "DerivationProof", call: func() error { _, err := k.DerivationProof(); return err }},
+		{name: "SignStatement", call: func() error { _, _, err := k.SignStatement("", "example.com", "abcdefgh"); return err }},
+		{name: "SignSplit", call: func() error { _, _, err := k.SignSplit(nil); return err }},
+		{name: "SignJWT", call: func() error { _, err := k.SignJWT(map[string]any{}); return err }},
+		{name: "COSEKey", call: func() error { _, err := k.COSEKey(); return err }},
+		{name: "DIDKey", call: func() error { _, err := k.DIDKey(); return err }},
+		{name: "Bech32Address", call: func() error { _, err := k.Bech32Address("cosmos"); return err }},
+		{name: "GitSigningKey", call: func() error { _, _, err := k.GitSigningKey("alice@example.com"); return err }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err != ErrInvalidPrivateKey {
+				t.Errorf("%s() error = %v, wantErr %v", tt.name, err, ErrInvalidPrivateKey)
+			}
+		})
+	}
+
+	if fields := k.ToKDBXFields(); fields != nil {
+		t.Errorf("ToKDBXFields() = %v, want nil", fields)
+	}
+	if uuid := k.UUID(); uuid != "" {
+		t.Errorf("UUID() = %q, want empty", uuid)
+	}
+	if fp := k.Fingerprint(); fp != [4]byte{} {
+		t.Errorf("Fingerprint() = %x, want zeros", fp)
+	}
+	if enc := k.PublicKeyEncodings(); enc != (PublicKeyEncodings{}) {
+		t.Errorf("PublicKeyEncodings() = %+v, want the zero value", enc)
+	}
+	if sign, _ := k.SigningFunc(); sign([]byte("msg")) != nil {
+		t.Errorf("SigningFunc() sign returned a signature, want nil")
+	}
+}
+
+func TestNode_LibsodiumKeypair(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	node, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	pk, sk := node.LibsodiumKeypair()
+
+	want := ed25519.NewKeyFromSeed(node.PrivateKey())
+	if !bytes.Equal(sk[:], want) {
+		t.Errorf("LibsodiumKeypair() sk = %X, want %X", sk, want)
+	}
+	if !bytes.Equal(pk[:], want.Public().(ed25519.PublicKey)) {
+		t.Errorf("LibsodiumKeypair() pk = %X, want %X", pk, want.Public())
+	}
+	if !bytes.Equal(sk[32:], pk[:]) {
+		t.Errorf("LibsodiumKeypair() sk does not end with pk")
+	}
+}
+
+func TestDeriveForPath_TooDeep(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr error
+	}{
+		{
+			name:    "max depth",
+			path:    "m" + strings.Repeat("/0'", MaxPathDepth),
+			wantErr: nil,
+		},
+		{
+			name:    "max depth + 1",
+			path:    "m" + strings.Repeat("/0'", MaxPathDepth+1),
+			wantErr: ErrPathTooDeep,
+		},
+		{
+			name:    "pathological",
+			path:    "m" + strings.Repeat("/0'", 100000),
+			wantErr: ErrPathTooDeep,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DeriveForPath(tt.path, seed)
+			if err != tt.wantErr {
+				t.Errorf("DeriveForPath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsValidPathWithDepth(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		maxDepth int
+		want     bool
+	}{
+		{name: "within limit", path: "m/44'/501'/0'", maxDepth: 3, want: true},
+		{name: "over limit", path: "m/44'/501'/0'/0'", maxDepth: 3, want: false},
+		{name: "no limit", path: "m" + strings.Repeat("/0'", 1000), maxDepth: 0, want: true},
+		{name: "invalid", path: "m/44", maxDepth: 3, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidPathWithDepth(tt.path, tt.maxDepth); got != tt.want {
+				t.Errorf("IsValidPathWithDepth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidPath_Pathological(t *testing.T) {
+	if IsValidPath("m" + strings.Repeat("/0'", 100000)) {
+		t.Errorf("IsValidPath() = true, want false")
+	}
+}
+
+// BenchmarkIsValidPath_Pathological shows that huge paths are rejected by counting
+// their segments, without running the regex over them.
+func BenchmarkIsValidPath_Pathological(b *testing.B) {
+	path := "m" + strings.Repeat("/0'", 100000)
+	for b.Loop() {
+		IsValidPath(path)
+	}
+}
+
+func TestDeriveForPathRaw(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	tests := []struct {
+		name     string
+		path     string
+		wantPriv []byte
+		wantErr  error
+	}{
+		{
+			name:     "hardened child numbers",
+			path:     "m/0'/1'",
+			wantPriv: hexMustDecode("b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2"),
+		},
+		{
+			name:     "raw indices",
+			path:     "m/2147483648/2147483649",
+			wantPriv: hexMustDecode("b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2"),
+		},
+		{
+			name:     "mixed",
+			path:     "m/2147483648/1'",
+			wantPriv: hexMustDecode("b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2"),
+		},
+		{
+			name:    "raw index below 2^31",
+			path:    "m/0",
+			wantErr: ErrNoPublicDerivation,
+		},
+		{
+			name:    "hardened marker on raw index",
+			path:    "m/2147483648'",
+			wantErr: ErrInvalidPath,
+		},
+		{
+			name:    "overflow",
+			path:    "m/4294967296",
+			wantErr: ErrInvalidPath,
+		},
+		{
+			name:    "invalid",
+			path:    "m/0h",
+			wantErr: ErrInvalidPath,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeriveForPathRaw(tt.path, seed)
+			if err != tt.wantErr {
+				t.Errorf("DeriveForPathRaw() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			if priv := got.PrivateKey(); !bytes.Equal(priv, tt.wantPriv) {
+				t.Errorf("PrivateKey() = %X, want %X", priv, tt.wantPriv)
+			}
+		})
+	}
+
+	// DeriveForPath always adds the hardened offset, so "m/2147483648'" is out of range
+	if _, err := DeriveForPath("m/2147483648'", seed); err != ErrInvalidPath {
+		t.Errorf("DeriveForPath() error = %v, wantErr %v", err, ErrInvalidPath)
+	}
+}
+
+func TestNode_Derive_CannotDerive(t *testing.T) {
+	master, err := NewMasterNode(hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("NewMasterNode() error = %v", err)
+	}
+	full := master.(*node)
+
+	tests := []struct {
+		name string
+		node *node
+	}{
+		{name: "no chain code", node: &node{key: full.key}},
+		{name: "short chain code", node: &node{key: full.key, chainCode: full.chainCode[:16]}},
+		{name: "no private key", node: &node{chainCode: full.chainCode}},
+		{name: "nil node", node: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.node.Derive(FirstHardenedIndex)
+			if !errors.Is(err, ErrCannotDerive) {
+				t.Errorf("Derive() error = %v, wantErr %v", err, ErrCannotDerive)
+			}
+		})
+	}
+}
+
+func TestDeriveForPath_MasterMatchesNewMasterNode(t *testing.T) {
+	// walkPath starts from NewMasterNode, so "m" must give the master node itself
+	seeds := [][]byte{
+		hexMustDecode("000102030405060708090a0b0c0d0e0f"),
+		hexMustDecode("fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542"),
+		{0x01},
+	}
+	for _, seed := range seeds {
+		t.Run(hex.EncodeToString(seed), func(t *testing.T) {
+			master, err := NewMasterNode(seed)
+			if err != nil {
+				t.Fatalf("NewMasterNode() error = %v", err)
+			}
+			got, err := DeriveForPath("m", seed)
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+
+			if !bytes.Equal(got.(*node).key, master.(*node).key) {
+				t.Errorf("DeriveForPath() key = %x, want %x", got.(*node).key, master.(*node).key)
+			}
+			if !bytes.Equal(got.(*node).chainCode, master.(*node).chainCode) {
+				t.Errorf("DeriveForPath() chainCode = %x, want %x", got.(*node).chainCode, master.(*node).chainCode)
+			}
+		})
+	}
+}
+
+func TestNode_DeriveChild(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	master, err := NewMasterNode(seed)
+	if err != nil {
+		t.Fatalf("NewMasterNode() error = %v", err)
+	}
+	want, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	child, err := master.DeriveChild(0, true)
+	if err != nil {
+		t.Fatalf("DeriveChild() error = %v", err)
+	}
+	got, err := child.DeriveChild(1, true)
+	if err != nil {
+		t.Fatalf("DeriveChild() error = %v", err)
+	}
+	if !bytes.Equal(got.HMACOutput(), want.HMACOutput()) {
+		t.Errorf("DeriveChild() = %x, want %x", got.HMACOutput(), want.HMACOutput())
+	}
+
+	tests := []struct {
+		name        string
+		childNumber uint32
+		hardened    bool
+		wantErr     error
+	}{
+		// FirstHardenedIndex + FirstHardenedIndex would wrap around to the non-hardened child 0
+		{name: "hardened bit already set", childNumber: FirstHardenedIndex, hardened: true, wantErr: ErrInvalidIndex},
+		{name: "raw index", childNumber: FirstHardenedIndex + 1, hardened: true, wantErr: ErrInvalidIndex},
+		{name: "non-hardened", childNumber: 1, hardened: false, wantErr: ErrNoPublicDerivation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := master.DeriveChild(tt.childNumber, tt.hardened); !errors.Is(err, tt.wantErr) {
+				t.Errorf("DeriveChild() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNode_SupportsPublicDerivation(t *testing.T) {
+	node, err := DeriveForPath("m/0'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	if node.SupportsPublicDerivation() {
+		t.Errorf("SupportsPublicDerivation() = true, want false")
+	}
+	if _, err := node.Derive(0); err != ErrNoPublicDerivation {
+		t.Errorf("Derive() error = %v, want %v", err, ErrNoPublicDerivation)
+	}
+}
+
+func TestNewMasterNodeFromChunks(t *testing.T) {
+	seed := hexMustDecode("fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542")
+
+	tests := []struct {
+		name    string
+		chunks  [][]byte
+		want    []byte
+		wantErr error
+	}{
+		{name: "single chunk", chunks: [][]byte{seed}, want: seed},
+		{name: "several chunks", chunks: [][]byte{seed[:10], seed[10:11], seed[11:]}, want: seed},
+		{name: "empty chunks", chunks: [][]byte{nil, seed[:32], {}, seed[32:]}, want: seed},
+		{name: "shortest seed", chunks: [][]byte{seed[:8], seed[8:16]}, want: seed[:16]},
+		{name: "too short", chunks: [][]byte{seed[:8], seed[8:15]}, wantErr: ErrInvalidSeedLength},
+		{name: "too long", chunks: [][]byte{seed, seed[:1]}, wantErr: ErrInvalidSeedLength},
+		{name: "no chunks", wantErr: ErrInvalidSeedLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewMasterNodeFromChunks(tt.chunks...)
+			if err != tt.wantErr {
+				t.Fatalf("NewMasterNodeFromChunks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			want, err := NewMasterNode(tt.want)
+			if err != nil {
+				t.Fatalf("NewMasterNode() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("NewMasterNodeFromChunks() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestNode_HMACOutput(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want []byte
+	}{
+		{
+			name: "Key(m) – master node",
+			path: "m",
+			want: hexMustDecode("2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7" +
+				"90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb"),
+		},
+		{
+			name: "Key(m/0')",
+			path: "m/0'",
+			want: hexMustDecode("68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3" +
+				"8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := DeriveForPath(tt.path, hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+
+			got := node.HMACOutput()
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("HMACOutput() = %x, want %x", got, tt.want)
+			}
+			// the result is a copy
+			got[0] ^= 0xff
+			if !bytes.Equal(node.HMACOutput(), tt.want) {
+				t.Errorf("HMACOutput() changed after modifying its result")
+			}
+		})
+	}
+}
+
+func TestDerivePathNodes(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	tests := []struct {
+		name      string
+		path      string
+		wantPaths []string
+		wantErr   error
+	}{
+		{name: "master", path: "m", wantPaths: []string{"m"}},
+		{name: "Key(m/0'/1'/2')", path: "m/0'/1'/2'", wantPaths: []string{"m", "m/0'", "m/0'/1'", "m/0'/1'/2'"}},
+		{name: "invalid path", path: "m/0", wantErr: ErrInvalidPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DerivePathNodes(seed, tt.path)
+			if err != tt.wantErr {
+				t.Fatalf("DerivePathNodes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.wantPaths) {
+				t.Fatalf("DerivePathNodes() returned %d nodes, want %d", len(got), len(tt.wantPaths))
+			}
+			for i, path := range tt.wantPaths {
+				want, err := DeriveForPath(path, seed)
+				if err != nil {
+					t.Fatalf("DeriveForPath() error = %v", err)
+				}
+				if !bytes.Equal(got[i].HMACOutput(), want.HMACOutput()) {
+					t.Errorf("DerivePathNodes()[%d] = %x, want %x", i, got[i].HMACOutput(), want.HMACOutput())
+				}
+			}
+		})
+	}
+}
+
+func TestNewMasterNodeDomain(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	standard, err := NewMasterNode(seed)
+	if err != nil {
+		t.Fatalf("NewMasterNode() error = %v", err)
+	}
+	notes, err := NewMasterNodeDomain(seed, "example notes")
+	if err != nil {
+		t.Fatalf("NewMasterNodeDomain() error = %v", err)
+	}
+	chat, err := NewMasterNodeDomain(seed, "example chat")
+	if err != nil {
+		t.Fatalf("NewMasterNodeDomain() error = %v", err)
+	}
+
+	masters := []Node{standard, notes, chat}
+	for i := range masters {
+		for j := i + 1; j < len(masters); j++ {
+			if bytes.Equal(masters[i].HMACOutput()[:32], masters[j].HMACOutput()[:32]) ||
+				bytes.Equal(masters[i].HMACOutput()[32:], masters[j].HMACOutput()[32:]) {
+				t.Errorf("masters %d and %d share key material", i, j)
+			}
+		}
+	}
+
+	// the standard modifier gives the slip-10 master node
+	same, err := NewMasterNodeDomain(seed, seedModifier)
+	if err != nil {
+		t.Fatalf("NewMasterNodeDomain() error = %v", err)
+	}
+	if !bytes.Equal(same.HMACOutput(), standard.HMACOutput()) {
+		t.Errorf("NewMasterNodeDomain(%q) = %x, want %x", seedModifier, same.HMACOutput(), standard.HMACOutput())
+	}
+
+	if _, err := NewMasterNodeDomain(seed, ""); err != ErrInvalidDomain {
+		t.Errorf("NewMasterNodeDomain() error = %v, wantErr %v", err, ErrInvalidDomain)
+	}
+}
+
+func TestNilSeed(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(seed []byte) error
+	}{
+		{name: "NewMasterNode", call: func(seed []byte) error { _, err := NewMasterNode(seed); return err }},
+		{name: "NewMasterNodeDomain", call: func(seed []byte) error { _, err := NewMasterNodeDomain(seed, "example"); return err }},
+		{name: "DeriveForPath", call: func(seed []byte) error { _, err := DeriveForPath("m/0'", seed); return err }},
+		{name: "DeriveForPathRaw", call: func(seed []byte) error { _, err := DeriveForPathRaw("m/0'", seed); return err }},
+		{name: "DerivePathNodes", call: func(seed []byte) error { _, err := DerivePathNodes(seed, "m/0'"); return err }},
+		{name: "DeriveForPurpose", call: func(seed []byte) error { _, err := DeriveForPurpose(seed, PurposeBIP44, 501, 0, 0, 0); return err }},
+		{name: "NewDeriver", call: func(seed []byte) error { _, err := NewDeriver(seed); return err }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(nil); err != ErrInvalidSeedLength {
+				t.Errorf("%s(nil) error = %v, wantErr %v", tt.name, err, ErrInvalidSeedLength)
+			}
+			if err := tt.call([]byte{}); err != ErrInvalidSeedLength {
+				t.Errorf("%s([]byte{}) error = %v, wantErr %v", tt.name, err, ErrInvalidSeedLength)
+			}
+		})
+	}
+}
+
+func TestNode_NilReceiver(t *testing.T) {
+	var k *node
+
+	errorTests := []struct {
+		name string
+		call func() error
+	}{
+		{name: "Derive", call: func() error { _, err := k.Derive(FirstHardenedIndex); return err }},
+		{name: "DeriveChild", call: func() error { _, err := k.DeriveChild(0, true); return err }},
+		{name: "NextAddress", call: func() error { _, err := k.NextAddress(); return err }},
+		{name: "AncestorAt", call: func() error { _, err := k.AncestorAt(0); return err }},
+		{name: "DeriveShard", call: func() error {
+			return k.DeriveShard(0, 1, true, func(uint32, Node) error { return nil })
+		}},
+		{name: "SealBox", call: func() error { _, err := k.SealBox([]byte("message"), nil); return err }},
+		{name: "OpenBox", call: func() error { _, err := k.OpenBox(make([]byte, 64), nil); return err }},
+		{name: "Checkpoint", call: func() error { _, err := k.Checkpoint(testMACKey); return err }},
+		{name: "COSEKey", call: func() error { _, err := k.COSEKey(); return err }},
+		{name: "Bech32Address", call: func() error { _, err := k.Bech32Address("cosmos"); return err }},
+		{name: "DIDKey", call: func() error { _, err := k.DIDKey(); return err }},
+		{name: "SignJWT", call: func() error { _, err := k.SignJWT(map[string]any{"sub": "alice"}); return err }},
+		{name: "MasterFingerprint", call: func() error { _, err := k.MasterFingerprint(); return err }},
+		{name: "Path", call: func() error { _, err := k.Path(); return err }},
+		{name: "KeyOriginJSON", call: func() error { _, err := k.KeyOriginJSON(); return err }},
+		{name: "DerivationProof", call: func() error { _, err := k.DerivationProof(); return err }},
+		{name: "RequireMinDepth", call: func() error { return k.RequireMinDepth(0) }},
+		{name: "AccountLabel", call: func() error { _, err := k.AccountLabel("SOL"); return err }},
+		{name: "DeterministicShuffle", call: func() error { _, err := k.DeterministicShuffle(3, nil); return err }},
+		{name: "SignSplit", call: func() error { _, _, err := k.SignSplit([]byte("message")); return err }},
+		{name: "SignBatch", call: func() error { _, err := k.SignBatch([][]byte{[]byte("message")}); return err }},
+		{name: "SignStatement", call: func() error {
+			_, _, err := k.SignStatement("sign in", "example.com", "0123456789abcdef")
+			return err
+		}},
+		{name: "SolanaKeypairJSON", call: func() error { _, err := k.SolanaKeypairJSON(); return err }},
+		{name: "GitSigningKey", call: func() error { _, _, err := k.GitSigningKey("alice@example.com"); return err }},
+		{name: "DeriveToken", call: func() error { _, err := k.DeriveToken("label", 16); return err }},
+		{name: "RecoveryCode", call: func() error { _, err := k.RecoveryCode(4, 4); return err }},
+		{name: "DeriveNonce", call: func() error { _, err := k.DeriveNonce(nil, 0); return err }},
+		{name: "VRFProve", call: func() error { _, _, err := k.VRFProve([]byte("alpha")); return err }},
+		{name: "X25519PublicKey", call: func() error { _, err := k.X25519PublicKey(); return err }},
+		{name: "AgeX25519Identity", call: func() error { _, err := k.AgeX25519Identity(); return err }},
+		{name: "AgeRecipient", call: func() error { _, err := k.AgeRecipient(); return err }},
+		{name: "WireGuardKeys", call: func() error { _, _, err := k.WireGuardKeys(); return err }},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, ErrNilNode) {
+				t.Errorf("%s() error = %v, wantErr %v", tt.name, err, ErrNilNode)
+			}
+		})
+	}
+
+	zeroTests := []struct {
+		name string
+		call func() []any
+	}{
+		{name: "Keypair", call: func() []any { pub, priv := k.Keypair(); return []any{pub, priv} }},
+		{name: "LibsodiumKeypair", call: func() []any { pk, sk := k.LibsodiumKeypair(); return []any{pk, sk} }},
+		{name: "RawSeed", call: func() []any { return []any{k.RawSeed()} }},
+		{name: "HMACOutput", call: func() []any { return []any{k.HMACOutput()} }},
+		{name: "PrivateKey", call: func() []any { return []any{k.PrivateKey()} }},
+		{name: "PublicKeyWithPrefix", call: func() []any { return []any{k.PublicKeyWithPrefix()} }},
+		{name: "SupportsPublicDerivation", call: func() []any { return []any{k.SupportsPublicDerivation()} }},
+		{name: "Identicon", call: func() []any { return []any{k.Identicon()} }},
+		{name: "VerificationWord", call: func() []any { return []any{k.VerificationWord()} }},
+		{name: "ToKDBXFields", call: func() []any { return []any{k.ToKDBXFields()} }},
+		{name: "Fingerprint", call: func() []any { return []any{k.Fingerprint()} }},
+		{name: "SamePublic", call: func() []any { return []any{k.SamePublic(k)} }},
+		{name: "PublicKeyEncodings", call: func() []any { return []any{k.PublicKeyEncodings()} }},
+		{name: "Subtree", call: func() []any { key, chainCode := k.Subtree(); return []any{key, chainCode} }},
+		{name: "UUID", call: func() []any { return []any{k.UUID()} }},
+		{name: "X25519PrivateKey", call: func() []any { return []any{k.X25519PrivateKey()} }},
+	}
+	for _, tt := range zeroTests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, v := range tt.call() {
+				if !reflect.ValueOf(v).IsZero() {
+					t.Errorf("%s() result %d = %v, want the zero value", tt.name, i, v)
+				}
+			}
+		})
+	}
+
+	t.Run("Signer", func(t *testing.T) {
+		if signer := k.Signer(); signer != nil {
+			t.Errorf("Signer() = %v, want nil", signer)
+		}
+	})
+	t.Run("SigningFunc", func(t *testing.T) {
+		sign, cleanup := k.SigningFunc()
+		defer cleanup()
+		if sig := sign([]byte("message")); sig != nil {
+			t.Errorf("SigningFunc() sign = %x, want nil", sig)
+		}
+	})
+}
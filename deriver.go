@@ -0,0 +1,88 @@
+package slip10
+
+import (
+	"fmt"
+	"sync"
+)
+
+var ErrDeriverWiped = fmt.Errorf("deriver was wiped")
+
+// Deriver derives keys from a seed given once. It keeps the master node,
+// so every derivation starts from it instead of hashing the seed again.
+// It is safe for concurrent use.
+type Deriver struct {
+	mu     sync.RWMutex
+	master *node
+}
+
+// NewDeriver checks the seed as NewMasterNodeStrict does and returns a Deriver
+// bound to its master node.
+func NewDeriver(seed []byte) (*Deriver, error) {
+	master, err := NewMasterNodeStrict(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Deriver{master: master.(*node)}, nil
+}
+
+// ForPath derives key for a path in the format of DeriveForPath,
+// also accepting "h" and "H" as hardened markers.
+func (d *Deriver) ForPath(path string) (Node, error) {
+	indices, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.ForIndices(indices...)
+}
+
+// ForIndices derives key for raw indices, with FirstHardenedIndex already added to hardened ones.
+// Without indices it returns the master node itself, as Master does.
+// A nil Deriver behaves as a wiped one.
+func (d *Deriver) ForIndices(indices ...uint32) (Node, error) {
+	if d == nil {
+		return nil, ErrDeriverWiped
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.master == nil {
+		return nil, ErrDeriverWiped
+	}
+
+	return deriveFrom(d.master, indices)
+}
+
+// Master returns the master node, or nil if the Deriver was wiped.
+// The node is the one the Deriver holds, not a copy: Wipe zeroes it, and a caller that
+// needs it to outlive the Deriver must copy its key and chain code, e.g. with Subtree.
+func (d *Deriver) Master() Node {
+	if d == nil {
+		return nil
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.master == nil {
+		return nil
+	}
+
+	return d.master
+}
+
+// Wipe zeroes the key and chain code of the master node, including in nodes
+// previously returned by Master, and makes further derivations fail with ErrDeriverWiped.
+// Nodes derived before keep their own keys, which the caller wipes, but hold no copy
+// of the master node, so none of the master key is left behind in them.
+func (d *Deriver) Wipe() {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.master == nil {
+		return
+	}
+
+	d.master.wipe()
+	d.master = nil
+}
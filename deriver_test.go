@@ -0,0 +1,170 @@
+package slip10
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestDeriver(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	d, err := NewDeriver(seed)
+	if err != nil {
+		t.Fatalf("NewDeriver() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		derive  func() (Node, error)
+		path    string
+		wantErr error
+	}{
+		{name: "ForPath", derive: func() (Node, error) { return d.ForPath("m/0'/1'") }, path: "m/0'/1'"},
+		{name: "ForPath master", derive: func() (Node, error) { return d.ForPath("m") }, path: "m"},
+		{
+			name:   "ForIndices",
+			derive: func() (Node, error) { return d.ForIndices(FirstHardenedIndex, FirstHardenedIndex+1) },
+			path:   "m/0'/1'",
+		},
+		{name: "ForPath h notation", derive: func() (Node, error) { return d.ForPath("m/0h/1H") }, path: "m/0'/1'"},
+		{name: "invalid path", derive: func() (Node, error) { return d.ForPath("m/0") }, wantErr: ErrInvalidPath},
+		{
+			name:    "too deep path",
+			derive:  func() (Node, error) { return d.ForPath("m" + strings.Repeat("/0'", MaxPathDepth+1)) },
+			wantErr: ErrPathTooDeep,
+		},
+		{name: "non-hardened index", derive: func() (Node, error) { return d.ForIndices(0) }, wantErr: ErrNoPublicDerivation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.derive()
+			if err != tt.wantErr {
+				t.Fatalf("derive error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			want, err := DeriveForPath(tt.path, seed)
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+			if !bytes.Equal(got.RawSeed(), want.RawSeed()) {
+				t.Errorf("derived key = %x, want %x", got.RawSeed(), want.RawSeed())
+			}
+		})
+	}
+}
+
+func TestDeriver_Wipe(t *testing.T) {
+	d, err := NewDeriver(hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("NewDeriver() error = %v", err)
+	}
+	master := d.Master()
+	masterKey, masterChainCode := master.Subtree()
+	child, err := d.ForPath("m/0'")
+	if err != nil {
+		t.Fatalf("ForPath() error = %v", err)
+	}
+	childSeed := append([]byte(nil), child.RawSeed()...)
+
+	d.Wipe()
+	d.Wipe()
+
+	if !bytes.Equal(master.RawSeed(), make([]byte, Ed25519KeyLen)) {
+		t.Errorf("Master().RawSeed() after Wipe = %x, want zeroes", master.RawSeed())
+	}
+	if got := d.Master(); got != nil {
+		t.Errorf("Master() after Wipe = %v, want nil", got)
+	}
+	if _, err := d.ForPath("m/0'"); err != ErrDeriverWiped {
+		t.Errorf("ForPath() after Wipe error = %v, wantErr %v", err, ErrDeriverWiped)
+	}
+	if !bytes.Equal(child.RawSeed(), childSeed) {
+		t.Errorf("derived node changed after Wipe")
+	}
+	// the child derived before Wipe holds no copy of the master node
+	if k := child.(*node); k.ancestors != nil {
+		t.Errorf("derived node keeps %d ancestors after Wipe", len(k.ancestors))
+	}
+	for _, b := range [][]byte{child.RawSeed(), child.(*node).chainCode} {
+		if bytes.Equal(b, masterKey) || bytes.Equal(b, masterChainCode) {
+			t.Errorf("derived node holds master key material after Wipe")
+		}
+	}
+	if _, err := child.NextAddress(); err != ErrNoParent {
+		t.Errorf("NextAddress() after Wipe error = %v, wantErr %v", err, ErrNoParent)
+	}
+}
+
+func TestDeriver_NoMasterCopy(t *testing.T) {
+	d, err := NewDeriver(hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("NewDeriver() error = %v", err)
+	}
+	fromIndices, err := d.ForIndices(FirstHardenedIndex)
+	if err != nil {
+		t.Fatalf("ForIndices() error = %v", err)
+	}
+	fromPath, err := d.ForPath("m/0'/1'")
+	if err != nil {
+		t.Fatalf("ForPath() error = %v", err)
+	}
+
+	for _, n := range []Node{fromIndices, fromPath} {
+		if k := n.(*node); k.ancestors != nil {
+			t.Errorf("node at depth %d keeps %d ancestors", k.depth, len(k.ancestors))
+		}
+	}
+}
+
+func TestNewDeriver_InvalidSeed(t *testing.T) {
+	if _, err := NewDeriver(make([]byte, 8)); err != ErrInvalidSeedLength {
+		t.Errorf("NewDeriver() error = %v, wantErr %v", err, ErrInvalidSeedLength)
+	}
+}
+
+func TestDeriver_Nil(t *testing.T) {
+	var d *Deriver
+
+	if _, err := d.ForPath("m/0'"); err != ErrDeriverWiped {
+		t.Errorf("ForPath() error = %v, wantErr %v", err, ErrDeriverWiped)
+	}
+	if _, err := d.ForIndices(FirstHardenedIndex); err != ErrDeriverWiped {
+		t.Errorf("ForIndices() error = %v, wantErr %v", err, ErrDeriverWiped)
+	}
+	if d.Master() != nil {
+		t.Errorf("Master() = non-nil, want nil")
+	}
+	d.Wipe()
+}
+
+func TestDeriver_WipeConcurrent(t *testing.T) {
+	d, err := NewDeriver(hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("NewDeriver() error = %v", err)
+	}
+	master := d.Master().(*node)
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, err := d.ForPath("m/0'/1'"); err != nil && err != ErrDeriverWiped {
+				t.Errorf("ForPath() error = %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			d.Wipe()
+		}()
+	}
+	wg.Wait()
+
+	if !bytes.Equal(master.key, make([]byte, Ed25519KeyLen)) || !bytes.Equal(master.chainCode, make([]byte, ChainCodeLen)) {
+		t.Errorf("master after Wipe = %x %x, want zeroes", master.key, master.chainCode)
+	}
+}
@@ -0,0 +1,21 @@
+package slip10
+
+// ed25519Multicodec is the varint encoded multicodec code of ed25519 public keys, 0xed.
+var ed25519Multicodec = []byte{0xed, 0x01}
+
+// DIDKey returns the did:key identifier of the node's ed25519 public key, e.g. "did:key:z6Mk...",
+// as in https://w3c-ccg.github.io/did-method-key/: the public key prefixed with the ed25519-pub
+// multicodec 0xed01, then multibase encoded as base58btc, marked with "z".
+// It returns ErrInvalidPrivateKey for nodes without a 32-byte private key.
+func (k *node) DIDKey() (string, error) {
+	pub, _, err := k.keypair()
+	if err != nil {
+		return "", err
+	}
+	return didKey(pub), nil
+}
+
+// didKey returns the did:key identifier of a bare ed25519 public key.
+func didKey(pub []byte) string {
+	return "did:key:z" + base58Encode(append(append([]byte(nil), ed25519Multicodec...), pub...))
+}
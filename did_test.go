@@ -0,0 +1,35 @@
+package slip10
+
+import "testing"
+
+func TestDIDKey(t *testing.T) {
+	// example of the did:key spec, publicKeyBase58 4zvwRjXUKGfvwnParsHAS3HuSVzV5cA4McphgmoCtajS
+	pub := hexMustDecode("3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29")
+	want := "did:key:z6MkiTBz1ymuepAQ4HEHYSF1H8quG5GLVVQR3djdX3mDooWp"
+
+	if got := didKey(pub); got != want {
+		t.Errorf("didKey() = %v, want %v", got, want)
+	}
+}
+
+func TestNode_DIDKey(t *testing.T) {
+	node, err := DeriveForPath("m/0'/1'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	got, err := node.DIDKey()
+	if err != nil {
+		t.Fatalf("DIDKey() error = %v", err)
+	}
+	// public key 1932a527...5c1187
+	if want := "did:key:z6Mkg9d2cuNwvtRYsXZJzyzMLxAipW4YKpPKGBhTZrcpd84n"; got != want {
+		t.Errorf("DIDKey() = %v, want %v", got, want)
+	}
+}
+
+func TestNode_DIDKey_NoPrivateKey(t *testing.T) {
+	if _, err := (&node{}).DIDKey(); err != ErrInvalidPrivateKey {
+		t.Errorf("DIDKey() error = %v, wantErr %v", err, ErrInvalidPrivateKey)
+	}
+}
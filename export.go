@@ -0,0 +1,204 @@
+package slip10
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+)
+
+// PublicKeyListJSON derives the children basePath/0' to basePath/(count-1)' and returns
+// their bare 32-byte public keys as a JSON array of hex strings, sorted in ascending order.
+// The output only depends on the inputs and contains no secrets, so it can be kept in version control.
+func PublicKeyListJSON(seed []byte, basePath string, count uint32) ([]byte, error) {
+	pubs, err := childPublicKeys(seed, basePath, count)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, count)
+	for _, pub := range pubs {
+		keys = append(keys, hex.EncodeToString(pub))
+	}
+	sort.Strings(keys)
+
+	return json.Marshal(keys)
+}
+
+// childPublicKeys derives the children basePath/0' to basePath/(count-1)'
+// and returns their bare 32-byte public keys in index order.
+func childPublicKeys(seed []byte, basePath string, count uint32) ([][]byte, error) {
+	if count > FirstHardenedIndex {
+		return nil, ErrInvalidIndex
+	}
+
+	base, err := DeriveForPath(basePath, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	pubs := make([][]byte, 0, count)
+	for i := uint32(0); i < count; i++ {
+		child, err := base.Derive(i + FirstHardenedIndex)
+		if err != nil {
+			return nil, err
+		}
+		pub, _ := child.Keypair()
+		pubs = append(pubs, pub)
+	}
+
+	return pubs, nil
+}
+
+// SortedPublicKeys derives every path, in the format of DeriveForPath or with "h" markers, from the seed
+// and returns the bare 32-byte public keys in ascending byte order without duplicates,
+// so that the list is canonical whatever the order of the paths, e.g. for an on-chain allowlist.
+// The master node is computed once for all paths. An invalid path returns an error naming it.
+func SortedPublicKeys(seed []byte, paths []string) ([][]byte, error) {
+	master, err := NewMasterNode(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	pubs := make([][]byte, 0, len(paths))
+	for _, path := range paths {
+		indices, err := parsePath(path)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", err, path)
+		}
+
+		key, err := deriveFrom(master, indices)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", err, path)
+		}
+		pub, _ := key.Keypair()
+		pubs = append(pubs, pub)
+	}
+	sort.Slice(pubs, func(i, j int) bool {
+		return bytes.Compare(pubs[i], pubs[j]) < 0
+	})
+
+	return slices.CompactFunc(pubs, bytes.Equal), nil
+}
+
+// WriteAddressCSV derives the children basePath/0' to basePath/(count-1)' and writes them to w
+// as CSV with an "index,path,address" header, one row per child in index order.
+// The address column is the result of encode, which turns a node into a chain-specific
+// address or public key string. Rows are written as they are derived, so any count can be exported.
+func WriteAddressCSV(w io.Writer, seed []byte, basePath string, count uint32, encode func(Node) string) error {
+	if count > FirstHardenedIndex {
+		return ErrInvalidIndex
+	}
+
+	base, err := DeriveForPath(basePath, seed)
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"index", "path", "address"}); err != nil {
+		return err
+	}
+	for i := uint32(0); i < count; i++ {
+		child, err := base.Derive(i + FirstHardenedIndex)
+		if err != nil {
+			return err
+		}
+		path, err := child.Path()
+		if err != nil {
+			return err
+		}
+
+		if err := cw.Write([]string{strconv.FormatUint(uint64(i), 10), path, encode(child)}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// WalletSpec describes the keys exported by StreamWalletJSON: for every account
+// from 0 to Accounts-1, the addresses m/Purpose'/Coin'/account'/0'/0' to
+// m/Purpose'/Coin'/account'/0'/(Addresses-1)'.
+type WalletSpec struct {
+	Purpose   uint32
+	Coin      uint32
+	Accounts  uint32
+	Addresses uint32
+}
+
+// walletEntry is an element of the array written by StreamWalletJSON.
+type walletEntry struct {
+	Account   uint32 `json:"account"`
+	Index     uint32 `json:"index"`
+	Path      string `json:"path"`
+	PublicKey string `json:"publicKey"`
+}
+
+// StreamWalletJSON derives the keys of the spec and writes them to w as a JSON array of
+// {"account", "index", "path", "publicKey"} objects, ordered by account and then by index,
+// with the bare 32-byte public keys hex encoded. Every entry is written as soon as it is
+// derived, so exports of any size use constant memory. The output only depends on the inputs.
+func StreamWalletJSON(w io.Writer, seed []byte, spec WalletSpec) error {
+	if spec.Purpose >= FirstHardenedIndex || spec.Coin >= FirstHardenedIndex ||
+		spec.Accounts > FirstHardenedIndex || spec.Addresses > FirstHardenedIndex {
+		return ErrInvalidIndex
+	}
+
+	coin, err := deriveIndices(seed, []uint32{spec.Purpose + FirstHardenedIndex, spec.Coin + FirstHardenedIndex})
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	for account := uint32(0); account < spec.Accounts; account++ {
+		accountKey, err := coin.Derive(account + FirstHardenedIndex)
+		if err != nil {
+			return err
+		}
+		change, err := accountKey.Derive(FirstHardenedIndex)
+		if err != nil {
+			return err
+		}
+
+		for index := uint32(0); index < spec.Addresses; index++ {
+			child, err := change.Derive(index + FirstHardenedIndex)
+			if err != nil {
+				return err
+			}
+			path, err := child.Path()
+			if err != nil {
+				return err
+			}
+			pub, _ := child.Keypair()
+
+			entry, err := json.Marshal(walletEntry{
+				Account:   account,
+				Index:     index,
+				Path:      path,
+				PublicKey: hex.EncodeToString(pub),
+			})
+			if err != nil {
+				return err
+			}
+			if _, err := io.WriteString(w, sep); err != nil {
+				return err
+			}
+			if _, err := w.Write(entry); err != nil {
+				return err
+			}
+			sep = ","
+		}
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
@@ -0,0 +1,208 @@
+package slip10
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPublicKeyListJSON(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	type args struct {
+		basePath string
+		count    uint32
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []byte
+		wantErr error
+	}{
+		{
+			name: "sorted children",
+			args: args{basePath: "m/0'", count: 3},
+			// m/0'/1' sorts before m/0'/0'
+			want: []byte(`[` +
+				`"1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187",` +
+				`"83a5c9e49e3652b2548bc955ed699e5dfbc357e51b512dbc3b435ef38f16d59e",` +
+				`"c491d84acd0688d327ff679c6f954599254337d114e4bbf512e8e72ea36cb88e"` +
+				`]`),
+		},
+		{
+			name: "no children",
+			args: args{basePath: "m/0'", count: 0},
+			want: []byte(`[]`),
+		},
+		{
+			name:    "invalid base path",
+			args:    args{basePath: "m/0", count: 1},
+			wantErr: ErrInvalidPath,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PublicKeyListJSON(seed, tt.args.basePath, tt.args.count)
+			if err != tt.wantErr {
+				t.Errorf("PublicKeyListJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("PublicKeyListJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortedPublicKeys(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	// public keys start with c491, 8c8a, 1932, a4b2 and 83a5
+	paths := []string{"m/0'/2'", "m/0'", "m/0'/1'", "m", "m/0'/1'", "m/0'/0'", "m/0'"}
+	got, err := SortedPublicKeys(seed, paths)
+	if err != nil {
+		t.Fatalf("SortedPublicKeys() error = %v", err)
+	}
+
+	wantPaths := []string{"m/0'/1'", "m/0'/0'", "m/0'", "m", "m/0'/2'"}
+	if len(got) != len(wantPaths) {
+		t.Fatalf("SortedPublicKeys() returned %d keys, want %d", len(got), len(wantPaths))
+	}
+	for i, path := range wantPaths {
+		node, err := DeriveForPath(path, seed)
+		if err != nil {
+			t.Fatalf("DeriveForPath() error = %v", err)
+		}
+		if want, _ := node.Keypair(); !bytes.Equal(got[i], want) {
+			t.Errorf("SortedPublicKeys()[%d] = %x, want %x of %s", i, got[i], want, path)
+		}
+	}
+
+	_, err = SortedPublicKeys(seed, []string{"m/0'", "m/1"})
+	if !errors.Is(err, ErrInvalidPath) || !strings.Contains(err.Error(), `"m/1"`) {
+		t.Errorf("SortedPublicKeys() error = %v, want ErrInvalidPath naming m/1", err)
+	}
+}
+
+func TestWriteAddressCSV(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	encode := func(n Node) string {
+		pub, _ := n.Keypair()
+		return hex.EncodeToString(pub)
+	}
+
+	type args struct {
+		basePath string
+		count    uint32
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "children",
+			args: args{basePath: "m/0'", count: 2},
+			want: "index,path,address\n" +
+				"0,m/0'/0',83a5c9e49e3652b2548bc955ed699e5dfbc357e51b512dbc3b435ef38f16d59e\n" +
+				"1,m/0'/1',1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187\n",
+		},
+		{
+			name: "no children",
+			args: args{basePath: "m/0'", count: 0},
+			want: "index,path,address\n",
+		},
+		{
+			name:    "invalid base path",
+			args:    args{basePath: "m/0", count: 1},
+			wantErr: ErrInvalidPath,
+		},
+		{
+			name:    "too many children",
+			args:    args{basePath: "m/0'", count: FirstHardenedIndex + 1},
+			wantErr: ErrInvalidIndex,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := WriteAddressCSV(&buf, seed, tt.args.basePath, tt.args.count, encode)
+			if err != tt.wantErr {
+				t.Errorf("WriteAddressCSV() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == nil && buf.String() != tt.want {
+				t.Errorf("WriteAddressCSV() = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestStreamWalletJSON(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	tests := []struct {
+		name      string
+		spec      WalletSpec
+		wantPaths []string
+		wantErr   error
+	}{
+		{
+			name:      "accounts and addresses",
+			spec:      WalletSpec{Purpose: PurposeBIP44, Coin: 501, Accounts: 2, Addresses: 2},
+			wantPaths: []string{"m/44'/501'/0'/0'/0'", "m/44'/501'/0'/0'/1'", "m/44'/501'/1'/0'/0'", "m/44'/501'/1'/0'/1'"},
+		},
+		{
+			name:      "single address",
+			spec:      WalletSpec{Purpose: PurposeBIP44, Coin: 501, Accounts: 1, Addresses: 1},
+			wantPaths: []string{"m/44'/501'/0'/0'/0'"},
+		},
+		{
+			name:      "no accounts",
+			spec:      WalletSpec{Purpose: PurposeBIP44, Coin: 501},
+			wantPaths: []string{},
+		},
+		{
+			name:    "hardened coin",
+			spec:    WalletSpec{Purpose: PurposeBIP44, Coin: FirstHardenedIndex, Accounts: 1, Addresses: 1},
+			wantErr: ErrInvalidIndex,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := StreamWalletJSON(&buf, seed, tt.spec)
+			if err != tt.wantErr {
+				t.Fatalf("StreamWalletJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			var entries []walletEntry
+			if err := json.Unmarshal(buf.Bytes(), &entries); err != nil {
+				t.Fatalf("StreamWalletJSON() = %s is not valid JSON: %v", buf.Bytes(), err)
+			}
+			if len(entries) != len(tt.wantPaths) {
+				t.Fatalf("StreamWalletJSON() has %d entries, want %d", len(entries), len(tt.wantPaths))
+			}
+			for i, entry := range entries {
+				if entry.Path != tt.wantPaths[i] {
+					t.Errorf("StreamWalletJSON() entry %d path = %v, want %v", i, entry.Path, tt.wantPaths[i])
+				}
+				want, err := DeriveForPath(entry.Path, seed)
+				if err != nil {
+					t.Fatalf("DeriveForPath() error = %v", err)
+				}
+				pub, _ := want.Keypair()
+				if entry.PublicKey != hex.EncodeToString(pub) {
+					t.Errorf("StreamWalletJSON() entry %d publicKey = %v, want %x", i, entry.PublicKey, pub)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package slip10
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+)
+
+const (
+	// extendedKeyPayloadLen is the length of a serialized BIP-32 extended key without its checksum:
+	// version(4) || depth(1) || parent fingerprint(4) || child number(4) || chain code(32) || key(33).
+	extendedKeyPayloadLen  = 78
+	extendedKeyChecksumLen = 4
+)
+
+var (
+	ErrBadChecksum = fmt.Errorf("bad extended key checksum")
+	ErrBadLength   = fmt.Errorf("bad extended key length")
+)
+
+// VerifyExtendedKeyChecksum checks the integrity of a base58 BIP-32 extended key
+// such as an xpub or xprv, without parsing it: the decoded key must be 82 bytes long
+// and end with the first 4 bytes of the double SHA-256 of the other 78 bytes.
+// It returns ErrInvalidBase58, ErrBadLength or ErrBadChecksum, so that corrupted or
+// truncated keys can be reported before they are used.
+func VerifyExtendedKeyChecksum(s string) error {
+	_, err := decodeExtendedKey(s)
+	return err
+}
+
+// SameMaster checks that base58 BIP-32 extended keys descend from the same master node
+// and returns the fingerprint of that master.
+// Extended keys do not carry the master fingerprint, only the fingerprint of their parent,
+// so it can be read only from depth 1 keys, whose parent is the master, and from depth 0
+// public keys, which are the master itself: for those the fingerprint is computed from
+// the compressed secp256k1 public key, since a 0x00 prefixed key may be private.
+// Any other key returns ErrUnknownOrigin: its provenance cannot be checked without its ancestors.
+// Every key is decoded and checked first, so an invalid key returns an error
+// even if an earlier key already shows another master. Every key must pass
+// VerifyExtendedKeyChecksum. If the masters differ, same is false and the fingerprint is zero.
+func SameMaster(extendedKeys []string) (same bool, fingerprint [4]byte, err error) {
+	if len(extendedKeys) == 0 {
+		return false, fingerprint, ErrUnknownOrigin
+	}
+
+	fingerprints := make([][4]byte, len(extendedKeys))
+	for i, s := range extendedKeys {
+		data, err := decodeExtendedKey(s)
+		if err != nil {
+			return false, [4]byte{}, fmt.Errorf("%w: key %d", err, i)
+		}
+
+		depth, key := data[4], data[45:extendedKeyPayloadLen]
+		switch {
+		case depth == 1:
+			copy(fingerprints[i][:], data[5:9])
+		case depth == 0 && (key[0] == 0x02 || key[0] == 0x03):
+			copy(fingerprints[i][:], hash160(key))
+		default:
+			return false, [4]byte{}, fmt.Errorf("%w: key %d has depth %d", ErrUnknownOrigin, i, depth)
+		}
+	}
+
+	for _, fp := range fingerprints[1:] {
+		if fp != fingerprints[0] {
+			return false, [4]byte{}, nil
+		}
+	}
+	return true, fingerprints[0], nil
+}
+
+// decodeExtendedKey decodes a base58 extended key and checks its length and checksum,
+// returning the 78-byte payload.
+func decodeExtendedKey(s string) ([]byte, error) {
+	data, err := base58Decode(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != extendedKeyPayloadLen+extendedKeyChecksumLen {
+		return nil, ErrBadLength
+	}
+
+	first := sha256.Sum256(data[:extendedKeyPayloadLen])
+	second := sha256.Sum256(first[:])
+	if subtle.ConstantTimeCompare(second[:extendedKeyChecksumLen], data[extendedKeyPayloadLen:]) != 1 {
+		return nil, ErrBadChecksum
+	}
+
+	return data[:extendedKeyPayloadLen], nil
+}
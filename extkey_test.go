@@ -0,0 +1,73 @@
+package slip10
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestVerifyExtendedKeyChecksum(t *testing.T) {
+	// BIP-32 test vector 1, chain m
+	xpub := "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+	xprv := "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"
+
+	tests := []struct {
+		name    string
+		s       string
+		wantErr error
+	}{
+		{name: "xpub", s: xpub},
+		{name: "xprv", s: xprv},
+		{name: "changed character", s: xpub[:50] + "x" + xpub[51:], wantErr: ErrBadChecksum},
+		{name: "truncated", s: xpub[:len(xpub)-1], wantErr: ErrBadLength},
+		{name: "empty", s: "", wantErr: ErrBadLength},
+		{name: "not base58", s: "0" + xpub[1:], wantErr: ErrInvalidBase58},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := VerifyExtendedKeyChecksum(tt.s); err != tt.wantErr {
+				t.Errorf("VerifyExtendedKeyChecksum() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSameMaster(t *testing.T) {
+	// BIP-32 test vector 1: m, m/0H and m/0H/1, master fingerprint 3442193e
+	v1Master := "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+	v1Child := "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw"
+	v1Grandchild := "xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ"
+	v1MasterPrivate := "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"
+	// BIP-32 test vector 2: m and m/0, master fingerprint bd16bee5
+	v2Master := "xpub661MyMwAqRbcFW31YEwpkMuc5THy2PSt5bDMsktWQcFF8syAmRUapSCGu8ED9W6oDMSgv6Zz8idoc4a6mr8BDzTJY47LJhkJ8UB7WEGuduB"
+	v2Child := "xpub69H7F5d8KSRgmmdJg2KhpAK8SR3DjMwAdkxj3ZuxV27CprR9LgpeyGmXUbC6wb7ERfvrnKZjXoUmmDznezpbZb7ap6r1D3tgFxHmwMkQTPH"
+
+	tests := []struct {
+		name    string
+		keys    []string
+		want    bool
+		wantFP  [4]byte
+		wantErr error
+	}{
+		{name: "master and child", keys: []string{v1Master, v1Child}, want: true, wantFP: [4]byte{0x34, 0x42, 0x19, 0x3e}},
+		{name: "child only", keys: []string{v2Child}, want: true, wantFP: [4]byte{0xbd, 0x16, 0xbe, 0xe5}},
+		{name: "master and child of vector 2", keys: []string{v2Child, v2Master}, want: true, wantFP: [4]byte{0xbd, 0x16, 0xbe, 0xe5}},
+		{name: "different masters", keys: []string{v1Master, v2Child}, want: false},
+		{name: "grandchild", keys: []string{v1Master, v1Grandchild}, wantErr: ErrUnknownOrigin},
+		{name: "private master", keys: []string{v1MasterPrivate}, wantErr: ErrUnknownOrigin},
+		{name: "no keys", keys: nil, wantErr: ErrUnknownOrigin},
+		{name: "corrupted key", keys: []string{v1Master, v1Child[:50] + "x" + v1Child[51:]}, wantErr: ErrBadChecksum},
+		{name: "corrupted key after a mismatch", keys: []string{v1Master, v2Child, v1Child[:50] + "x" + v1Child[51:]}, wantErr: ErrBadChecksum},
+		{name: "grandchild after a mismatch", keys: []string{v1Master, v2Child, v1Grandchild}, wantErr: ErrUnknownOrigin},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, fp, err := SameMaster(tt.keys)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SameMaster() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want || fp != tt.wantFP {
+				t.Errorf("SameMaster() = %v, %x, want %v, %x", got, fp, tt.want, tt.wantFP)
+			}
+		})
+	}
+}
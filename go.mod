@@ -1,3 +1,13 @@
 module github.com/anyproto/go-slip10
 
-go 1.13
+go 1.24.0
+
+require (
+	filippo.io/age v1.2.1
+	filippo.io/edwards25519 v1.2.0
+	github.com/golang-jwt/jwt/v5 v5.2.1
+	golang.org/x/crypto v0.45.0
+	golang.org/x/text v0.31.0
+)
+
+require golang.org/x/sys v0.38.0 // indirect
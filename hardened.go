@@ -0,0 +1,54 @@
+package slip10
+
+import "fmt"
+
+// minHardenedBase is the smallest hardened offset accepted by DeriveForPathWithHardened.
+const minHardenedBase = 1 << 8
+
+var ErrInvalidHardenedBase = fmt.Errorf("invalid hardened base")
+
+// DeriveForPathWithHardened derives key for a path in the format of DeriveForPath,
+// where "h" or "H" may replace the apostrophe, and a seed,
+// adding hardenedBase instead of FirstHardenedIndex to the child number of every segment,
+// for research on alternative derivation layouts. Children are derived with the ed25519
+// hardened formula whatever their index, so with any hardenedBase other than FirstHardenedIndex
+// the keys are incompatible with SLIP-0010 and every other implementation.
+// hardenedBase must be a power of two from 2^8 to 2^31, otherwise ErrInvalidHardenedBase
+// is returned, and child numbers must be below it.
+// The derived node has no origin and no parent, as Path and NextAddress assume the standard offset.
+func DeriveForPathWithHardened(path string, seed []byte, hardenedBase uint32) (Node, error) {
+	if hardenedBase < minHardenedBase || hardenedBase > FirstHardenedIndex || hardenedBase&(hardenedBase-1) != 0 {
+		return nil, ErrInvalidHardenedBase
+	}
+	indices, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	master, err := NewMasterNode(seed)
+	if err != nil {
+		return nil, err
+	}
+	k := master.(*node)
+	for _, i := range indices {
+		n := i - FirstHardenedIndex
+		if n >= hardenedBase {
+			clear(k.key)
+			return nil, fmt.Errorf("%w: child number %d is not below %d", ErrInvalidPath, n, hardenedBase)
+		}
+
+		sum, err := k.childHMAC(n + hardenedBase)
+		clear(k.key)
+		if err != nil {
+			return nil, err
+		}
+		k = &node{
+			key:       sum[:Ed25519KeyLen],
+			chainCode: sum[Ed25519KeyLen:],
+			depth:     k.depth + 1,
+			index:     n + hardenedBase,
+		}
+	}
+
+	return &node{key: k.key, chainCode: k.chainCode, depth: k.depth, index: k.index}, nil
+}
@@ -0,0 +1,72 @@
+package slip10
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestDeriveForPathWithHardened(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	// the standard offset gives the slip-10 keys
+	got, err := DeriveForPathWithHardened("m/0'/1'", seed, FirstHardenedIndex)
+	if err != nil {
+		t.Fatalf("DeriveForPathWithHardened() error = %v", err)
+	}
+	want, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	if !bytes.Equal(got.HMACOutput(), want.HMACOutput()) {
+		t.Errorf("DeriveForPathWithHardened() = %x, want %x", got.HMACOutput(), want.HMACOutput())
+	}
+
+	// another offset gives the children at the shifted indices
+	got, err = DeriveForPathWithHardened("m/0'/1'", seed, 1<<16)
+	if err != nil {
+		t.Fatalf("DeriveForPathWithHardened() error = %v", err)
+	}
+	master, err := NewMasterNode(seed)
+	if err != nil {
+		t.Fatalf("NewMasterNode() error = %v", err)
+	}
+	sum, err := master.(*node).childHMAC(1 << 16)
+	if err != nil {
+		t.Fatalf("childHMAC() error = %v", err)
+	}
+	sum, err = (&node{key: sum[:32], chainCode: sum[32:]}).childHMAC(1<<16 + 1)
+	if err != nil {
+		t.Fatalf("childHMAC() error = %v", err)
+	}
+	if !bytes.Equal(got.HMACOutput(), sum) {
+		t.Errorf("DeriveForPathWithHardened() = %x, want %x", got.HMACOutput(), sum)
+	}
+	if _, err := got.Path(); err != ErrUnknownOrigin {
+		t.Errorf("Path() error = %v, wantErr %v", err, ErrUnknownOrigin)
+	}
+}
+
+func TestDeriveForPathWithHardened_Invalid(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		hardenedBase uint32
+		wantErr      error
+	}{
+		{name: "zero base", path: "m/0'", hardenedBase: 0, wantErr: ErrInvalidHardenedBase},
+		{name: "small base", path: "m/0'", hardenedBase: 128, wantErr: ErrInvalidHardenedBase},
+		{name: "not a power of two", path: "m/0'", hardenedBase: 3 << 10, wantErr: ErrInvalidHardenedBase},
+		{name: "above 2^31", path: "m/0'", hardenedBase: 0xffffffff, wantErr: ErrInvalidHardenedBase},
+		{name: "child number too large", path: "m/0'/256'", hardenedBase: 256, wantErr: ErrInvalidPath},
+		{name: "non-hardened segment", path: "m/0", hardenedBase: 256, wantErr: ErrInvalidPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DeriveForPathWithHardened(tt.path, hexMustDecode("000102030405060708090a0b0c0d0e0f"), tt.hardenedBase)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("DeriveForPathWithHardened() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package slip10
+
+import "strings"
+
+// identiconEmoji maps a nibble of the fingerprint to an emoji.
+// The table must never change: users learn to recognize their accounts by it.
+var identiconEmoji = [16]string{
+	"🍎", "🐝", "🌵", "🐬", "🥚", "🦊", "🍇", "🐴",
+	"🍦", "🎈", "🔑", "🍋", "🍄", "🌙", "🐙", "🐧",
+}
+
+// Identicon returns a short visual fingerprint of the node for wallet UIs: 8 emoji,
+// one for each 4-bit nibble of Fingerprint, high nibble of the first byte first.
+// Nibbles 0x0 to 0xf map to 🍎 🐝 🌵 🐬 🥚 🦊 🍇 🐴 🍦 🎈 🔑 🍋 🍄 🌙 🐙 🐧.
+// It depends on the public key only and the mapping is stable across versions.
+func (k *node) Identicon() string {
+	if k == nil {
+		return ""
+	}
+	fp := k.Fingerprint()
+
+	var b strings.Builder
+	for _, v := range fp {
+		b.WriteString(identiconEmoji[v>>4])
+		b.WriteString(identiconEmoji[v&0x0f])
+	}
+	return b.String()
+}
+
+// VerificationWord returns a single word to read out for a quick out-of-band check of a node,
+// for example in support scenarios: the word of the BIP-39 English wordlist
+// at the index given by the first 11 bits of Fingerprint, big-endian.
+// It depends on the public key only and the mapping is stable across versions.
+func (k *node) VerificationWord() string {
+	if k == nil {
+		return ""
+	}
+	fp := k.Fingerprint()
+	return bip39English[int(fp[0])<<3|int(fp[1])>>5]
+}
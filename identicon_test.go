@@ -0,0 +1,57 @@
+package slip10
+
+import "testing"
+
+func TestNode_Identicon(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		// fingerprint ddebc675
+		{name: "master", path: "m", want: "🌙🌙🐙🍋🍄🍇🐴🦊"},
+		// fingerprint 13dab143
+		{name: "m/0'", path: "m/0'", want: "🐝🐬🌙🔑🍋🐝🥚🐬"},
+		// fingerprint ebe4cb29
+		{name: "m/0'/1'", path: "m/0'/1'", want: "🐙🍋🐙🥚🍄🍋🌵🎈"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := DeriveForPath(tt.path, hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+
+			if got := node.Identicon(); got != tt.want {
+				t.Errorf("Identicon() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNode_VerificationWord(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		// fingerprint ddebc675, index 1775
+		{name: "master", path: "m", want: "target"},
+		// fingerprint 13dab143, index 158
+		{name: "m/0'", path: "m/0'", want: "because"},
+		// fingerprint ebe4cb29, index 1887
+		{name: "m/0'/1'", path: "m/0'/1'", want: "typical"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := DeriveForPath(tt.path, hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+
+			if got := node.VerificationWord(); got != tt.want {
+				t.Errorf("VerificationWord() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package slip10
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// jwtHeader is the JOSE header of EdDSA JSON Web Tokens, RFC 8037.
+const jwtHeader = `{"alg":"EdDSA","typ":"JWT"}`
+
+var ErrInvalidClaims = fmt.Errorf("invalid JWT claims")
+
+// SignJWT returns a compact JSON Web Token with the claims, signed with the node's ed25519
+// private key using the EdDSA algorithm of RFC 8037, as verified by JWT libraries'
+// EdDSA method with the node's public key. The claims are encoded with encoding/json,
+// so the same node and claims always give the same token.
+// Registered claims such as "exp" and "iat" are not added: they are the caller's to set.
+// It returns ErrInvalidPrivateKey for nodes without a 32-byte private key and for wiped nodes,
+// and ErrInvalidClaims for nil claims, which encode as null instead of a JSON object.
+func (k *node) SignJWT(claims map[string]any) (string, error) {
+	_, priv, err := k.signingKeypair()
+	if err != nil {
+		return "", err
+	}
+	defer clear(priv)
+	if claims == nil {
+		return "", ErrInvalidClaims
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString([]byte(jwtHeader)) + "." +
+		base64.RawURLEncoding.EncodeToString(payload)
+	sig := ed25519.Sign(priv, []byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
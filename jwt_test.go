@@ -0,0 +1,102 @@
+package slip10
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestNode_SignJWT(t *testing.T) {
+	node, err := DeriveForPath("m/0'/1'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	got, err := node.SignJWT(map[string]any{"sub": "alice", "admin": true, "iat": 1700000000})
+	if err != nil {
+		t.Fatalf("SignJWT() error = %v", err)
+	}
+	want := "eyJhbGciOiJFZERTQSIsInR5cCI6IkpXVCJ9." +
+		"eyJhZG1pbiI6dHJ1ZSwiaWF0IjoxNzAwMDAwMDAwLCJzdWIiOiJhbGljZSJ9." +
+		"SVBysg2gyqAm-JILNb2D7fQAZWsJfLOF4hNg4e_pYxN3q-F57F62_5XDW6mJD6gKuLyfzPi8WxAvd4jj8oZTCg"
+	if got != want {
+		t.Errorf("SignJWT() = %v, want %v", got, want)
+	}
+
+	parts := strings.Split(got, ".")
+	if len(parts) != 3 {
+		t.Fatalf("SignJWT() has %d parts, want 3", len(parts))
+	}
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		t.Fatalf("DecodeString() header error = %v", err)
+	}
+	if wantHeader := `{"alg":"EdDSA","typ":"JWT"}`; string(header) != wantHeader {
+		t.Errorf("SignJWT() header = %s, want %s", header, wantHeader)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatalf("DecodeString() payload error = %v", err)
+	}
+	var claims map[string]any
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		t.Fatalf("Unmarshal() payload error = %v", err)
+	}
+	if claims["sub"] != "alice" || claims["admin"] != true || claims["iat"] != float64(1700000000) {
+		t.Errorf("SignJWT() claims = %v", claims)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatalf("DecodeString() signature error = %v", err)
+	}
+	pub, _ := node.Keypair()
+	if !ed25519.Verify(pub, []byte(parts[0]+"."+parts[1]), sig) {
+		t.Errorf("SignJWT() signature does not verify over header.payload with the node's public key")
+	}
+
+	// the token must also be accepted by a JWT library
+	token, err := jwt.Parse(got, func(*jwt.Token) (any, error) {
+		return pub, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg()}))
+	if err != nil {
+		t.Fatalf("jwt.Parse() error = %v", err)
+	}
+	if !token.Valid {
+		t.Errorf("jwt.Parse() token is not valid")
+	}
+	if sub, err := token.Claims.GetSubject(); err != nil || sub != "alice" {
+		t.Errorf("jwt.Parse() subject = %q, %v, want alice", sub, err)
+	}
+
+	otherPub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	if _, err := jwt.Parse(got, func(*jwt.Token) (any, error) {
+		return otherPub, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg()})); err == nil {
+		t.Errorf("jwt.Parse() with another public key error = nil, want an error")
+	}
+}
+
+func TestNode_SignJWT_Invalid(t *testing.T) {
+	k, err := DeriveForPath("m/0'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	if _, err := k.SignJWT(map[string]any{"bad": func() {}}); err == nil {
+		t.Errorf("SignJWT() with a claim that cannot be encoded error = nil, want an error")
+	}
+	if _, err := k.SignJWT(nil); err != ErrInvalidClaims {
+		t.Errorf("SignJWT() with nil claims error = %v, wantErr %v", err, ErrInvalidClaims)
+	}
+	if _, err := (&node{}).SignJWT(nil); err != ErrInvalidPrivateKey {
+		t.Errorf("SignJWT() error = %v, wantErr %v", err, ErrInvalidPrivateKey)
+	}
+}
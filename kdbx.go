@@ -0,0 +1,36 @@
+package slip10
+
+import "encoding/hex"
+
+// ToKDBXFields returns the node as the string fields of a password manager entry,
+// for use with any KeePass (KDBX) library:
+//   - "Title": the path, or the fingerprint if the origin is unknown;
+//   - "UserName" and "PublicKey": the hex encoded bare 32-byte public key;
+//   - "Fingerprint": the hex encoded fingerprint;
+//   - "Path": the path, only if the origin is known;
+//   - "Password": the hex encoded 32-byte private key.
+//
+// "Password" is the only secret field and it gives full control of the key,
+// so it must be stored with the same care as the seed. KeePass protects it in memory
+// and hides it in its UI, unlike the other fields.
+// It returns nil for nodes without a 32-byte private key.
+func (k *node) ToKDBXFields() map[string]string {
+	pub, priv, err := k.keypair()
+	if err != nil {
+		return nil
+	}
+	fingerprint := k.Fingerprint()
+
+	fields := map[string]string{
+		"Title":       hex.EncodeToString(fingerprint[:]),
+		"UserName":    hex.EncodeToString(pub),
+		"PublicKey":   hex.EncodeToString(pub),
+		"Fingerprint": hex.EncodeToString(fingerprint[:]),
+		"Password":    hex.EncodeToString(priv.Seed()),
+	}
+	if path, err := k.Path(); err == nil {
+		fields["Title"] = path
+		fields["Path"] = path
+	}
+	return fields
+}
@@ -0,0 +1,58 @@
+package slip10
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNode_ToKDBXFields(t *testing.T) {
+	node, err := DeriveForPath("m/0'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	checkpoint, err := node.Checkpoint(testMACKey)
+	if err != nil {
+		t.Fatalf("Checkpoint() error = %v", err)
+	}
+	resumed, err := ResumeFromCheckpoint(checkpoint, testMACKey)
+	if err != nil {
+		t.Fatalf("ResumeFromCheckpoint() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		node Node
+		want map[string]string
+	}{
+		{
+			name: "known origin",
+			node: node,
+			want: map[string]string{
+				"Title":       "m/0'",
+				"UserName":    "8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c",
+				"PublicKey":   "8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c",
+				"Fingerprint": "13dab143",
+				"Path":        "m/0'",
+				"Password":    "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+			},
+		},
+		{
+			name: "unknown origin",
+			node: resumed,
+			want: map[string]string{
+				"Title":       "13dab143",
+				"UserName":    "8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c",
+				"PublicKey":   "8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c",
+				"Fingerprint": "13dab143",
+				"Password":    "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.node.ToKDBXFields(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToKDBXFields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
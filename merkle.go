@@ -0,0 +1,57 @@
+package slip10
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"sort"
+)
+
+// DerivedKeysMerkleRoot derives the children basePath/0' to basePath/(count-1)'
+// and returns the SHA-256 Merkle root of their bare 32-byte public keys, e.g. to publish
+// a commitment to an allowlist. The tree is built as follows:
+//   - the public keys are sorted in ascending byte order;
+//   - every leaf is SHA-256(0x00 || public key);
+//   - every inner node is SHA-256(0x01 || left || right);
+//   - on a level with an odd number of nodes, the last node is paired with itself.
+//
+// The root of a single key is its leaf hash. count must be at least 1.
+func DerivedKeysMerkleRoot(seed []byte, basePath string, count uint32) ([]byte, error) {
+	if count == 0 {
+		return nil, ErrInvalidIndex
+	}
+
+	pubs, err := childPublicKeys(seed, basePath, count)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pubs, func(i, j int) bool {
+		return bytes.Compare(pubs[i], pubs[j]) < 0
+	})
+
+	level := make([][]byte, len(pubs))
+	for i, pub := range pubs {
+		level[i] = merkleHash(0x00, pub)
+	}
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			right := level[i]
+			if i+1 < len(level) {
+				right = level[i+1]
+			}
+			next = append(next, merkleHash(0x01, level[i], right))
+		}
+		level = next
+	}
+
+	return level[0], nil
+}
+
+func merkleHash(prefix byte, parts ...[]byte) []byte {
+	hash := sha256.New()
+	hash.Write([]byte{prefix})
+	for _, p := range parts {
+		hash.Write(p)
+	}
+	return hash.Sum(nil)
+}
@@ -0,0 +1,59 @@
+package slip10
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDerivedKeysMerkleRoot(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	type args struct {
+		basePath string
+		count    uint32
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []byte
+		wantErr error
+	}{
+		{
+			name: "single key",
+			args: args{basePath: "m/0'", count: 1},
+			// SHA-256(0x00 || pub(m/0'/0'))
+			want: hexMustDecode("5e8c8927c7dc0fd58bb0fb0eeb047e48b0db49a0136aacdbd8549a60444dde5e"),
+		},
+		{
+			name: "two keys",
+			args: args{basePath: "m/0'", count: 2},
+			want: hexMustDecode("b3f37850689d0f3b5f29e66d40a79760fec904235aee936b2531525e40d87225"),
+		},
+		{
+			name: "odd number of keys",
+			args: args{basePath: "m/0'", count: 3},
+			want: hexMustDecode("5956e64e1e82ee99226bb02ec51b816b615772f97800d80f25d088ba1de2954b"),
+		},
+		{
+			name:    "no keys",
+			args:    args{basePath: "m/0'", count: 0},
+			wantErr: ErrInvalidIndex,
+		},
+		{
+			name:    "invalid base path",
+			args:    args{basePath: "m/0", count: 1},
+			wantErr: ErrInvalidPath,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DerivedKeysMerkleRoot(seed, tt.args.basePath, tt.args.count)
+			if err != tt.wantErr {
+				t.Fatalf("DerivedKeysMerkleRoot() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("DerivedKeysMerkleRoot() = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package slip10
+
+import (
+	"crypto/pbkdf2"
+	"crypto/sha512"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// As in https://github.com/bitcoin/bips/blob/master/bip-0039.mediawiki#from-mnemonic-to-seed
+	mnemonicSaltPrefix = "mnemonic"
+	mnemonicIterations = 2048
+	mnemonicSeedLen    = 64
+
+	// PreviewPath is the path of the address returned by PreviewWallet, the first Solana account.
+	PreviewPath = "m/44'/501'/0'/0'"
+)
+
+var ErrInvalidMnemonic = fmt.Errorf("invalid mnemonic")
+
+// SeedFromMnemonic returns the 64-byte BIP-39 seed of a mnemonic sentence and a passphrase:
+// PBKDF2-HMAC-SHA512 with 2048 iterations over the NFKD normalized mnemonic,
+// salted with "mnemonic" and the NFKD normalized passphrase.
+// Like BIP-39 seed generation, it does not check the words against a wordlist.
+func SeedFromMnemonic(mnemonic, passphrase string) ([]byte, error) {
+	if strings.TrimSpace(mnemonic) == "" {
+		return nil, ErrInvalidMnemonic
+	}
+
+	password := norm.NFKD.String(mnemonic)
+	salt := norm.NFKD.String(mnemonicSaltPrefix + passphrase)
+	return pbkdf2.Key(sha512.New, password, []byte(salt), mnemonicIterations, mnemonicSeedLen)
+}
+
+// PreviewWallet returns the master fingerprint and the bare public key at PreviewPath
+// of the wallet of a mnemonic and a passphrase, so that a UI can ask "is this your wallet?"
+// before using it: a typo in the passphrase silently gives another valid wallet.
+// The seed and the derived nodes are wiped before it returns; the nodes keep no copies of their parents.
+func PreviewWallet(mnemonic, passphrase string) (fingerprint [4]byte, firstAddress []byte, err error) {
+	seed, err := SeedFromMnemonic(mnemonic, passphrase)
+	if err != nil {
+		return fingerprint, nil, err
+	}
+	defer clear(seed)
+
+	fingerprint, firstAddress, _, err = previewWallet(seed)
+	return fingerprint, firstAddress, err
+}
+
+// previewWallet is PreviewWallet for a seed. It also returns the nodes it derived,
+// already wiped, so that tests can check that nothing is left in them.
+func previewWallet(seed []byte) (fingerprint [4]byte, firstAddress []byte, nodes []*node, err error) {
+	defer func() {
+		for _, n := range nodes {
+			n.wipe()
+		}
+	}()
+	key, err := walkPath(PreviewPath, seed, func(n Node) {
+		nodes = append(nodes, n.(*node))
+	})
+	if err != nil {
+		return fingerprint, nil, nodes, err
+	}
+
+	pub, _ := key.Keypair()
+	return nodes[0].Fingerprint(), pub, nodes, nil
+}
@@ -0,0 +1,109 @@
+package slip10
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSeedFromMnemonic(t *testing.T) {
+	tests := []struct {
+		name       string
+		mnemonic   string
+		passphrase string
+		want       []byte
+		wantErr    error
+	}{
+		{
+			// https://github.com/trezor/python-mnemonic/blob/master/vectors.json
+			name:       "trezor vector",
+			mnemonic:   "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			passphrase: "TREZOR",
+			want:       hexMustDecode("c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04"),
+		},
+		{
+			name:       "composed passphrase is normalized",
+			mnemonic:   "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			passphrase: "Caf\u00e9",
+			want:       hexMustDecode("d17e00ec5acd5587f2d46c7df2ba3939331f2dee1afc1e833fed89e6a6b392fe5293b2fdd76976a9968bc74ac86cf7c9f69d0c1f0efe75e9dff5d07a7dc107d3"),
+		},
+		{
+			name:       "decomposed passphrase",
+			mnemonic:   "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			passphrase: "Cafe\u0301",
+			want:       hexMustDecode("d17e00ec5acd5587f2d46c7df2ba3939331f2dee1afc1e833fed89e6a6b392fe5293b2fdd76976a9968bc74ac86cf7c9f69d0c1f0efe75e9dff5d07a7dc107d3"),
+		},
+		{name: "empty mnemonic", mnemonic: " ", wantErr: ErrInvalidMnemonic},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SeedFromMnemonic(tt.mnemonic, tt.passphrase)
+			if err != tt.wantErr {
+				t.Fatalf("SeedFromMnemonic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("SeedFromMnemonic() = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPreviewWallet(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	fingerprint, firstAddress, err := PreviewWallet(mnemonic, "TREZOR")
+	if err != nil {
+		t.Fatalf("PreviewWallet() error = %v", err)
+	}
+
+	seed, err := SeedFromMnemonic(mnemonic, "TREZOR")
+	if err != nil {
+		t.Fatalf("SeedFromMnemonic() error = %v", err)
+	}
+	master, err := NewMasterNode(seed)
+	if err != nil {
+		t.Fatalf("NewMasterNode() error = %v", err)
+	}
+	if want := master.Fingerprint(); fingerprint != want {
+		t.Errorf("PreviewWallet() fingerprint = %x, want %x", fingerprint, want)
+	}
+	key, err := DeriveForPath(PreviewPath, seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	if want, _ := key.Keypair(); !bytes.Equal(firstAddress, want) {
+		t.Errorf("PreviewWallet() firstAddress = %x, want %x", firstAddress, want)
+	}
+
+	// a typo in the passphrase gives another wallet
+	typo, _, err := PreviewWallet(mnemonic, "TREZOr")
+	if err != nil {
+		t.Fatalf("PreviewWallet() error = %v", err)
+	}
+	if typo == fingerprint {
+		t.Errorf("PreviewWallet() with a typo has the same fingerprint %x", typo)
+	}
+}
+
+func TestPreviewWallet_Wiped(t *testing.T) {
+	seed, err := SeedFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	if err != nil {
+		t.Fatalf("SeedFromMnemonic() error = %v", err)
+	}
+
+	_, _, nodes, err := previewWallet(seed)
+	if err != nil {
+		t.Fatalf("previewWallet() error = %v", err)
+	}
+	if len(nodes) != pathDepth(PreviewPath)+1 {
+		t.Fatalf("previewWallet() derived %d nodes, want %d", len(nodes), pathDepth(PreviewPath)+1)
+	}
+	zero := make([]byte, Ed25519KeyLen)
+	for _, n := range nodes {
+		if !bytes.Equal(n.key, zero) || !bytes.Equal(n.chainCode, zero) {
+			t.Errorf("node at depth %d was not wiped", n.depth)
+		}
+		if n.ancestors != nil {
+			t.Errorf("node at depth %d keeps %d ancestor copies", n.depth, len(n.ancestors))
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package slip10
+
+// MoneroCoinType is the SLIP-44 coin type of Monero.
+const MoneroCoinType = uint32(128)
+
+// MoneroSpendSeed returns the 32-byte private key at m/44'/128'/account' for a seed,
+// to be passed as the spend seed to a Monero key generation library.
+// This is only the SLIP-0010 derivation step: reducing the seed to a spend key modulo l
+// and deriving the view key and subaddresses are left to that library.
+// The account must be a child number below 2^31, otherwise ErrInvalidIndex is returned.
+func MoneroSpendSeed(seed []byte, account uint32) ([]byte, error) {
+	if account >= FirstHardenedIndex {
+		return nil, ErrInvalidIndex
+	}
+
+	key, err := deriveIndices(seed, []uint32{
+		FirstHardenedIndex + PurposeBIP44,
+		FirstHardenedIndex + MoneroCoinType,
+		FirstHardenedIndex + account,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return key.RawSeed(), nil
+}
@@ -0,0 +1,33 @@
+package slip10
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestMoneroSpendSeed(t *testing.T) {
+	tests := []struct {
+		name    string
+		account uint32
+		want    []byte
+		wantErr error
+	}{
+		// m/44'/128'/0'
+		{name: "account 0", account: 0, want: hexMustDecode("3892dde8429f11ab5f57c26c7d9ab9fb036280b02177a9922d38fc97dfb10251")},
+		// m/44'/128'/1'
+		{name: "account 1", account: 1, want: hexMustDecode("d94d136adb3c49a90820293008fd2bd5f81e471b2c5b021aa6d86efb711084c9")},
+		{name: "hardened account", account: FirstHardenedIndex, wantErr: ErrInvalidIndex},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MoneroSpendSeed(hexMustDecode("000102030405060708090a0b0c0d0e0f"), tt.account)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MoneroSpendSeed() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("MoneroSpendSeed() = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
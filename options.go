@@ -0,0 +1,73 @@
+package slip10
+
+import "fmt"
+
+// BIP85Path is the application path of BIP-85 deterministic entropy,
+// https://github.com/bitcoin/bips/blob/master/bip-0085.mediawiki
+// Apps that use both BIP-85 and SLIP-0010 keys from the same seed can reserve it with WithReservedPaths.
+const BIP85Path = "m/83696968'"
+
+var ErrReservedPath = fmt.Errorf("path is reserved")
+
+// DeriveOption is an optional setting of DeriveForPath.
+type DeriveOption func(*deriveOptions)
+
+type deriveOptions struct {
+	reserved [][]uint32
+	ancestry bool
+	err      error
+}
+
+// WithReservedPaths makes DeriveForPath reject with ErrReservedPath the reserved paths
+// and every path below them, so that apps can partition the key space of a seed,
+// e.g. to keep SLIP-0010 keys out of BIP85Path.
+// Reserved paths are parsed as by CanonicalizePath; an invalid one makes DeriveForPath fail.
+func WithReservedPaths(paths []string) DeriveOption {
+	return func(o *deriveOptions) {
+		for _, path := range paths {
+			indices, err := parsePath(path)
+			if err != nil {
+				o.err = fmt.Errorf("%w: reserved path %q", err, path)
+				return
+			}
+			o.reserved = append(o.reserved, indices)
+		}
+	}
+}
+
+// WithAncestry makes DeriveForPath keep a copy of every node on the way in the derived node,
+// from the master node to its parent, so that AncestorAt can return them without deriving again
+// and NextAddress can derive the next sibling. Nodes derived from such a node with Derive
+// keep their ancestors too; other nodes keep no copy of their parent.
+// The copies hold private keys and stay in memory as long as the node.
+func WithAncestry() DeriveOption {
+	return func(o *deriveOptions) {
+		o.ancestry = true
+	}
+}
+
+// applyDeriveOptions applies opts and checks the path against them.
+func applyDeriveOptions(path string, opts []DeriveOption) (deriveOptions, error) {
+	var o deriveOptions
+	for _, opt := range opts {
+		opt(&o)
+		if o.err != nil {
+			return o, o.err
+		}
+	}
+	if len(o.reserved) == 0 {
+		return o, nil
+	}
+
+	indices, err := parsePath(path)
+	if err != nil {
+		return o, err
+	}
+	for _, prefix := range o.reserved {
+		if isPathPrefix(prefix, indices) {
+			return o, fmt.Errorf("%w: %q is under %q", ErrReservedPath, path, formatPath(prefix))
+		}
+	}
+
+	return o, nil
+}
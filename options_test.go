@@ -0,0 +1,53 @@
+package slip10
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestDeriveForPath_WithReservedPaths(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	reserved := WithReservedPaths([]string{BIP85Path, "m/1'/2'"})
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr error
+	}{
+		{name: "reserved path", path: "m/83696968'", wantErr: ErrReservedPath},
+		{name: "under reserved path", path: "m/83696968'/39'/0'", wantErr: ErrReservedPath},
+		{name: "under second reserved path", path: "m/1'/2'/3'", wantErr: ErrReservedPath},
+		{name: "parent of reserved path", path: "m/1'"},
+		{name: "sibling of reserved path", path: "m/1'/3'"},
+		{name: "master", path: "m"},
+		{name: "invalid path", path: "m/0", wantErr: ErrInvalidPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeriveForPath(tt.path, seed, reserved)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DeriveForPath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			want, err := DeriveForPath(tt.path, seed)
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+			if !bytes.Equal(got.RawSeed(), want.RawSeed()) {
+				t.Errorf("DeriveForPath() = %x, want %x", got.RawSeed(), want.RawSeed())
+			}
+		})
+	}
+}
+
+func TestWithReservedPaths_Invalid(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	if _, err := DeriveForPath("m/0'", seed, WithReservedPaths([]string{"m/83696968"})); !errors.Is(err, ErrInvalidPath) {
+		t.Errorf("DeriveForPath() error = %v, wantErr %v", err, ErrInvalidPath)
+	}
+}
@@ -0,0 +1,171 @@
+package slip10
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+var ErrUnknownOrigin = fmt.Errorf("unknown node origin")
+
+// Fingerprint returns the BIP-32 fingerprint of the node: the first 4 bytes of
+// RIPEMD160(SHA256(pub)) of the public key with the 0x00 prefix, as in the slip-10 test vectors.
+// It returns zeros for nodes without a 32-byte private key.
+func (k *node) Fingerprint() [4]byte {
+	var fp [4]byte
+	pub, _, err := k.keypair()
+	if err != nil {
+		return fp
+	}
+	copy(fp[:], hash160(AddPublicPrefix(pub)))
+	return fp
+}
+
+// MasterFingerprint returns the fingerprint of the master node the node was derived from.
+// It returns ErrUnknownOrigin for nodes that were not derived from a seed, e.g. restored from a checkpoint.
+func (k *node) MasterFingerprint() ([4]byte, error) {
+	if k == nil {
+		return [4]byte{}, ErrNilNode
+	}
+	if !k.hasOrigin {
+		return [4]byte{}, ErrUnknownOrigin
+	}
+
+	return k.masterFingerprint, nil
+}
+
+// Path returns the derivation path of the node from its master node, e.g. "m/44'/501'/0'".
+// It returns ErrUnknownOrigin for nodes that were not derived from a seed, e.g. restored from a checkpoint.
+func (k *node) Path() (string, error) {
+	if k == nil {
+		return "", ErrNilNode
+	}
+	if !k.hasOrigin {
+		return "", ErrUnknownOrigin
+	}
+
+	return formatPath(k.path), nil
+}
+
+// AncestorFingerprints derives the path from the seed and returns the fingerprint
+// of every node on the way, from the master node to the final node,
+// so the result has one more element than the path has segments.
+func AncestorFingerprints(seed []byte, path string) ([][4]byte, error) {
+	fingerprints := make([][4]byte, 0, pathDepth(path)+1)
+	_, err := walkPath(path, seed, func(n Node) {
+		fingerprints = append(fingerprints, n.Fingerprint())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fingerprints, nil
+}
+
+// SameWallet reports whether two seeds are backups of the same wallet
+// by comparing their master fingerprints in constant time.
+// The seeds are checked with DefaultStrictConfig and an error is returned if either is invalid.
+// Fingerprints are 4 bytes long, so unrelated seeds match with a probability of 2^-32.
+func SameWallet(seedA, seedB []byte) (bool, error) {
+	a, err := NewMasterNodeStrict(seedA)
+	if err != nil {
+		return false, err
+	}
+	b, err := NewMasterNodeStrict(seedB)
+	if err != nil {
+		return false, err
+	}
+
+	fpA, fpB := a.Fingerprint(), b.Fingerprint()
+	return subtle.ConstantTimeCompare(fpA[:], fpB[:]) == 1, nil
+}
+
+type keyOrigin struct {
+	MasterFingerprint string `json:"master_fingerprint"`
+	Path              string `json:"path"`
+	PubKey            string `json:"pubkey"`
+}
+
+// KeyOriginJSON returns the BIP-32 key origin of the node as used by PSBT and descriptor tooling:
+// {"master_fingerprint":"...","path":"m/...","pubkey":"..."}, where the public key is the bare
+// 32-byte ed25519 key in hex.
+// It returns ErrUnknownOrigin for nodes that were not derived from a seed, e.g. restored from a checkpoint.
+func (k *node) KeyOriginJSON() ([]byte, error) {
+	if k == nil {
+		return nil, ErrNilNode
+	}
+	path, err := k.Path()
+	if err != nil {
+		return nil, err
+	}
+	pub, _, err := k.keypair()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(keyOrigin{
+		MasterFingerprint: hex.EncodeToString(k.masterFingerprint[:]),
+		Path:              path,
+		PubKey:            hex.EncodeToString(pub),
+	})
+}
+
+// DerivationProof links a public key to the master node and the path it was derived with,
+// so that an auditor holding the seed can check it with VerifyDerivationProof.
+// It holds public data only.
+type DerivationProof struct {
+	MasterFingerprint [4]byte
+	// Path is in the format of DeriveForPath, e.g. "m/44'/501'/0'".
+	Path string
+	// PublicKey is the bare 32-byte ed25519 public key.
+	PublicKey []byte
+}
+
+// DerivationProof returns the master fingerprint, path and public key of the node.
+// It returns ErrUnknownOrigin for nodes that were not derived from a seed, e.g. restored from a checkpoint.
+func (k *node) DerivationProof() (DerivationProof, error) {
+	if k == nil {
+		return DerivationProof{}, ErrNilNode
+	}
+	path, err := k.Path()
+	if err != nil {
+		return DerivationProof{}, err
+	}
+	pub, _, err := k.keypair()
+	if err != nil {
+		return DerivationProof{}, err
+	}
+
+	return DerivationProof{
+		MasterFingerprint: k.masterFingerprint,
+		Path:              path,
+		PublicKey:         pub,
+	}, nil
+}
+
+// VerifyDerivationProof derives the proof's path from the seed and reports whether
+// both the master fingerprint and the public key match the proof.
+// It returns an error only if the path cannot be derived from the seed.
+func VerifyDerivationProof(seed []byte, proof DerivationProof) (bool, error) {
+	key, err := DeriveForPath(proof.Path, seed)
+	if err != nil {
+		return false, err
+	}
+	defer clear(key.(*node).key)
+
+	pub, _ := key.Keypair()
+	fp, err := key.MasterFingerprint()
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare(fp[:], proof.MasterFingerprint[:]) == 1 &&
+		subtle.ConstantTimeCompare(pub, proof.PublicKey) == 1, nil
+}
+
+func hash160(data []byte) []byte {
+	sha := sha256.Sum256(data)
+	sum := ripemd160Sum(sha[:])
+	return sum[:]
+}
@@ -0,0 +1,224 @@
+package slip10
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNode_Fingerprint(t *testing.T) {
+	// fingerprints from https://github.com/satoshilabs/slips/blob/master/slip-0010.md#test-vector-1-for-ed25519,
+	// where each child lists the fingerprint of its parent
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	tests := []struct {
+		name string
+		path string
+		want [4]byte
+	}{
+		{name: "Key(m) – master node", path: "m", want: [4]byte{0xdd, 0xeb, 0xc6, 0x75}},
+		{name: "Key(m/0')", path: "m/0'", want: [4]byte{0x13, 0xda, 0xb1, 0x43}},
+		{name: "Key(m/0'/1')", path: "m/0'/1'", want: [4]byte{0xeb, 0xe4, 0xcb, 0x29}},
+		{name: "Key(m/0'/1'/2')", path: "m/0'/1'/2'", want: [4]byte{0x31, 0x6e, 0xc1, 0xc6}},
+		{name: "Key(m/0'/1'/2'/2')", path: "m/0'/1'/2'/2'", want: [4]byte{0xd6, 0x32, 0x2c, 0xcd}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := DeriveForPath(tt.path, seed)
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+
+			if got := node.Fingerprint(); got != tt.want {
+				t.Errorf("Fingerprint() = %X, want %X", got, tt.want)
+			}
+
+			masterFp, err := node.MasterFingerprint()
+			if err != nil {
+				t.Fatalf("MasterFingerprint() error = %v", err)
+			}
+			if want := [4]byte{0xdd, 0xeb, 0xc6, 0x75}; masterFp != want {
+				t.Errorf("MasterFingerprint() = %X, want %X", masterFp, want)
+			}
+
+			path, err := node.Path()
+			if err != nil {
+				t.Fatalf("Path() error = %v", err)
+			}
+			if path != tt.path {
+				t.Errorf("Path() = %v, want %v", path, tt.path)
+			}
+		})
+	}
+}
+
+func TestNode_KeyOriginJSON(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	node, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	got, err := node.KeyOriginJSON()
+	if err != nil {
+		t.Fatalf("KeyOriginJSON() error = %v", err)
+	}
+	want := []byte(`{"master_fingerprint":"ddebc675","path":"m/0'/1'","pubkey":"1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"}`)
+	if !bytes.Equal(got, want) {
+		t.Errorf("KeyOriginJSON() = %s, want %s", got, want)
+	}
+
+	// nodes restored from a checkpoint have no known origin
+	blob, err := node.Checkpoint(testMACKey)
+	if err != nil {
+		t.Fatalf("Checkpoint() error = %v", err)
+	}
+	restored, err := ResumeFromCheckpoint(blob, testMACKey)
+	if err != nil {
+		t.Fatalf("ResumeFromCheckpoint() error = %v", err)
+	}
+	if _, err := restored.KeyOriginJSON(); err != ErrUnknownOrigin {
+		t.Errorf("KeyOriginJSON() error = %v, wantErr %v", err, ErrUnknownOrigin)
+	}
+}
+
+func TestAncestorFingerprints(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	tests := []struct {
+		name    string
+		path    string
+		want    [][4]byte
+		wantErr error
+	}{
+		{
+			name: "Key(m) – master node",
+			path: "m",
+			want: [][4]byte{{0xdd, 0xeb, 0xc6, 0x75}},
+		},
+		{
+			name: "Key(m/0'/1'/2')",
+			path: "m/0'/1'/2'",
+			want: [][4]byte{
+				{0xdd, 0xeb, 0xc6, 0x75},
+				{0x13, 0xda, 0xb1, 0x43},
+				{0xeb, 0xe4, 0xcb, 0x29},
+				{0x31, 0x6e, 0xc1, 0xc6},
+			},
+		},
+		{
+			name:    "invalid path",
+			path:    "m/0",
+			wantErr: ErrInvalidPath,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AncestorFingerprints(seed, tt.path)
+			if err != tt.wantErr {
+				t.Errorf("AncestorFingerprints() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && len(got) != pathDepth(tt.path)+1 {
+				t.Errorf("AncestorFingerprints() returned %d fingerprints for depth %d", len(got), pathDepth(tt.path))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AncestorFingerprints() = %X, want %X", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSameWallet(t *testing.T) {
+	seed1 := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	seed2 := hexMustDecode("fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542")
+
+	type args struct {
+		seedA []byte
+		seedB []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr error
+	}{
+		{name: "same seed", args: args{seedA: seed1, seedB: append([]byte(nil), seed1...)}, want: true},
+		{name: "different seeds", args: args{seedA: seed1, seedB: seed2}, want: false},
+		{name: "short seed", args: args{seedA: seed1, seedB: seed1[:8]}, wantErr: ErrInvalidSeedLength},
+		{name: "weak seed", args: args{seedA: make([]byte, 32), seedB: seed1}, wantErr: ErrWeakSeed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SameWallet(tt.args.seedA, tt.args.seedB)
+			if err != tt.wantErr {
+				t.Errorf("SameWallet() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("SameWallet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyDerivationProof(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	node, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	proof, err := node.DerivationProof()
+	if err != nil {
+		t.Fatalf("DerivationProof() error = %v", err)
+	}
+
+	want := DerivationProof{
+		MasterFingerprint: [4]byte{0xdd, 0xeb, 0xc6, 0x75},
+		Path:              "m/0'/1'",
+		PublicKey:         hexMustDecode("1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"),
+	}
+	if !reflect.DeepEqual(proof, want) {
+		t.Errorf("DerivationProof() = %+v, want %+v", proof, want)
+	}
+
+	otherKey := append([]byte(nil), proof.PublicKey...)
+	otherKey[0] ^= 0x01
+
+	tests := []struct {
+		name    string
+		seed    []byte
+		proof   DerivationProof
+		want    bool
+		wantErr error
+	}{
+		{name: "valid", seed: seed, proof: proof, want: true},
+		{name: "other path", seed: seed, proof: DerivationProof{proof.MasterFingerprint, "m/0'/2'", proof.PublicKey}},
+		{name: "other fingerprint", seed: seed, proof: DerivationProof{[4]byte{0xdd, 0xeb, 0xc6, 0x76}, proof.Path, proof.PublicKey}},
+		{name: "other public key", seed: seed, proof: DerivationProof{proof.MasterFingerprint, proof.Path, otherKey}},
+		{name: "truncated public key", seed: seed, proof: DerivationProof{proof.MasterFingerprint, proof.Path, proof.PublicKey[:31]}},
+		{name: "other seed", seed: hexMustDecode("000102030405060708090a0b0c0d0e0e"), proof: proof},
+		{name: "invalid path", seed: seed, proof: DerivationProof{proof.MasterFingerprint, "m/0/1", proof.PublicKey}, wantErr: ErrInvalidPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := VerifyDerivationProof(tt.seed, tt.proof)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VerifyDerivationProof() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("VerifyDerivationProof() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	key, chainCode := node.Subtree()
+	restored, err := NewNodeFromSubtree(key, chainCode, 2, CurveEd25519)
+	if err != nil {
+		t.Fatalf("NewNodeFromSubtree() error = %v", err)
+	}
+	if _, err := restored.DerivationProof(); err != ErrUnknownOrigin {
+		t.Errorf("DerivationProof() error = %v, wantErr %v", err, ErrUnknownOrigin)
+	}
+}
@@ -0,0 +1,227 @@
+package slip10
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// notationPathRegex matches paths with hardened segments marked either with an apostrophe or with "h"/"H".
+var notationPathRegex = regexp.MustCompile("^m(/[0-9]+['hH])*$")
+
+// CanonicalizePath returns the canonical form of a path, so that equivalent paths compare equal:
+// hardened markers "h" and "H" are replaced with apostrophes and leading zeros are removed,
+// e.g. "m/44h/0501'" becomes "m/44'/501'".
+func CanonicalizePath(path string) (string, error) {
+	indices, err := parsePath(path)
+	if err != nil {
+		return "", err
+	}
+
+	return formatPath(indices), nil
+}
+
+// PathNotations returns the canonical form of a path, as returned by CanonicalizePath,
+// and the same path with hardened segments marked with "h", e.g. "m/44'/0'" and "m/44h/0h",
+// for copying paths between tools that prefer one marker or the other.
+func PathNotations(path string) (apostrophe string, hNotation string, err error) {
+	apostrophe, err = CanonicalizePath(path)
+	if err != nil {
+		return "", "", err
+	}
+
+	return apostrophe, strings.ReplaceAll(apostrophe, "'", "h"), nil
+}
+
+// CheckUniquePaths returns the canonical form of every path that appears more than once,
+// in the order their duplicates are found. Paths are compared by their canonical form,
+// so "m/0'" and "m/0h" are duplicates.
+func CheckUniquePaths(paths []string) (duplicates []string, err error) {
+	seen := make(map[string]int, len(paths))
+	for _, path := range paths {
+		canonical, err := CanonicalizePath(path)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", err, path)
+		}
+
+		seen[canonical]++
+		if seen[canonical] == 2 {
+			duplicates = append(duplicates, canonical)
+		}
+	}
+
+	return duplicates, nil
+}
+
+// EstimateDerivations returns the number of Derive calls needed to derive every path
+// when nodes shared by several paths are derived only once, i.e. the number of nodes
+// below the master in the tree of all paths. Paths are parsed as by CanonicalizePath
+// and no key is derived, so it is cheap to call before scheduling a large job.
+func EstimateDerivations(paths []string) (total int, err error) {
+	nodes := make(map[string]struct{})
+	for _, path := range paths {
+		indices, err := parsePath(path)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %q", err, path)
+		}
+
+		for depth := 1; depth <= len(indices); depth++ {
+			nodes[formatPath(indices[:depth])] = struct{}{}
+		}
+	}
+
+	return len(nodes), nil
+}
+
+// IsAccountPath reports whether the path is a BIP-44 account path m/purpose'/coin'/account',
+// with exactly three hardened segments in any notation accepted by CanonicalizePath.
+func IsAccountPath(path string) bool {
+	indices, err := parsePath(path)
+	return err == nil && len(indices) == 3
+}
+
+// Relation is how two paths relate in the derivation tree, as returned by PathRelation.
+type Relation int
+
+const (
+	// RelationUnrelated is for paths that are none of the other relations, e.g. cousins.
+	RelationUnrelated Relation = iota
+	// RelationSame is for the same path, possibly written in another notation.
+	RelationSame
+	// RelationAncestor is for a first path that is a proper prefix of the second one.
+	RelationAncestor
+	// RelationDescendant is for a second path that is a proper prefix of the first one.
+	RelationDescendant
+	// RelationSibling is for different paths of the same depth sharing all but the last segment.
+	RelationSibling
+)
+
+// String returns the name of the relation, e.g. "sibling".
+func (r Relation) String() string {
+	switch r {
+	case RelationUnrelated:
+		return "unrelated"
+	case RelationSame:
+		return "same"
+	case RelationAncestor:
+		return "ancestor"
+	case RelationDescendant:
+		return "descendant"
+	case RelationSibling:
+		return "sibling"
+	default:
+		return fmt.Sprintf("Relation(%d)", int(r))
+	}
+}
+
+// PathRelation returns how the path a relates to the path b: RelationAncestor if a is an ancestor of b,
+// RelationDescendant if a is a descendant of b, and so on. Paths are compared segment by segment
+// after parsing as by CanonicalizePath, so "m/1'" is not an ancestor of "m/10'".
+// The master path "m" is the ancestor of every other path and has no siblings.
+func PathRelation(a, b string) (Relation, error) {
+	indicesA, err := parsePath(a)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", err, a)
+	}
+	indicesB, err := parsePath(b)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", err, b)
+	}
+
+	switch {
+	case len(indicesA) == len(indicesB) && isPathPrefix(indicesA, indicesB):
+		return RelationSame, nil
+	case isPathPrefix(indicesA, indicesB):
+		return RelationAncestor, nil
+	case isPathPrefix(indicesB, indicesA):
+		return RelationDescendant, nil
+	case len(indicesA) == len(indicesB) && isPathPrefix(indicesA[:len(indicesA)-1], indicesB):
+		return RelationSibling, nil
+	default:
+		return RelationUnrelated, nil
+	}
+}
+
+// ParsePathLenient parses paths written in the shorthand accepted by Electrum and other wallets
+// and returns their indices, with the hardened offset applied, and their canonical form.
+// On top of the strict syntax it accepts surrounding whitespace, an uppercase "M",
+// a missing "m/" prefix, a trailing slash, "h"/"H" hardened markers and non-hardened segments,
+// e.g. " 44h/0'/1/ " is parsed as "m/44'/0'/1".
+// Non-hardened segments are kept as such, so ed25519 derivation still rejects them.
+// Ambiguous input such as empty segments or several hardened markers on a segment is rejected.
+func ParsePathLenient(path string) ([]uint32, string, error) {
+	path = strings.TrimSpace(path)
+	switch {
+	case path == "m" || path == "M":
+		path = ""
+	case strings.HasPrefix(path, "m/") || strings.HasPrefix(path, "M/"):
+		path = path[2:]
+	}
+	path = strings.TrimSuffix(path, "/")
+	if path == "" {
+		return []uint32{}, "m", nil
+	}
+
+	segments := strings.Split(path, "/")
+	if len(segments) > MaxPathDepth {
+		return nil, "", ErrPathTooDeep
+	}
+
+	indices := make([]uint32, 0, len(segments))
+	for _, segment := range segments {
+		hardened := false
+		if n := len(segment); n > 0 && strings.IndexByte("'hH", segment[n-1]) >= 0 {
+			hardened = true
+			segment = segment[:n-1]
+		}
+		i64, err := strconv.ParseUint(segment, 10, 31)
+		if err != nil {
+			return nil, "", ErrInvalidPath
+		}
+		i := uint32(i64)
+		if hardened {
+			i += FirstHardenedIndex
+		}
+		indices = append(indices, i)
+	}
+
+	return indices, formatPath(indices), nil
+}
+
+// parsePath parses a path with hardened markers "'", "h" or "H" into indices with the hardened offset.
+func parsePath(path string) ([]uint32, error) {
+	if pathDepth(path) > MaxPathDepth {
+		return nil, ErrPathTooDeep
+	}
+	if !notationPathRegex.MatchString(path) {
+		return nil, ErrInvalidPath
+	}
+
+	segments := strings.Split(path, "/")
+	indices := make([]uint32, 0, len(segments)-1)
+	for _, segment := range segments[1:] {
+		i64, err := strconv.ParseUint(strings.TrimRight(segment, "'hH"), 10, 31)
+		if err != nil {
+			return nil, ErrInvalidPath
+		}
+		indices = append(indices, uint32(i64)+FirstHardenedIndex)
+	}
+
+	return indices, nil
+}
+
+// formatPath formats indices as a path, marking hardened indices with an apostrophe.
+func formatPath(indices []uint32) string {
+	buf := []byte{'m'}
+	for _, i := range indices {
+		buf = append(buf, '/')
+		if i >= FirstHardenedIndex {
+			buf = strconv.AppendUint(buf, uint64(i-FirstHardenedIndex), 10)
+			buf = append(buf, '\'')
+		} else {
+			buf = strconv.AppendUint(buf, uint64(i), 10)
+		}
+	}
+	return string(buf)
+}
@@ -0,0 +1,257 @@
+package slip10
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCanonicalizePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr error
+	}{
+		{name: "master", path: "m", want: "m"},
+		{name: "apostrophe", path: "m/44'/501'", want: "m/44'/501'"},
+		{name: "h notation", path: "m/44h/501H", want: "m/44'/501'"},
+		{name: "leading zeros", path: "m/044'/0'", want: "m/44'/0'"},
+		{name: "not hardened", path: "m/44'/0", wantErr: ErrInvalidPath},
+		{name: "overflow", path: "m/2147483648'", wantErr: ErrInvalidPath},
+		{name: "empty", path: "", wantErr: ErrInvalidPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CanonicalizePath(tt.path)
+			if err != tt.wantErr {
+				t.Errorf("CanonicalizePath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("CanonicalizePath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPathNotations(t *testing.T) {
+	tests := []struct {
+		path           string
+		wantApostrophe string
+		wantH          string
+		wantErr        error
+	}{
+		{path: "m/44'/0'", wantApostrophe: "m/44'/0'", wantH: "m/44h/0h"},
+		{path: "m/44h/0H/01'", wantApostrophe: "m/44'/0'/1'", wantH: "m/44h/0h/1h"},
+		{path: "m", wantApostrophe: "m", wantH: "m"},
+		{path: "m/44'/0", wantErr: ErrInvalidPath},
+		{path: "", wantErr: ErrInvalidPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			apostrophe, h, err := PathNotations(tt.path)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PathNotations() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if apostrophe != tt.wantApostrophe || h != tt.wantH {
+				t.Errorf("PathNotations() = %v, %v, want %v, %v", apostrophe, h, tt.wantApostrophe, tt.wantH)
+			}
+		})
+	}
+}
+
+func TestCheckUniquePaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		paths   []string
+		want    []string
+		wantErr error
+	}{
+		{
+			name:  "unique",
+			paths: []string{"m/0'", "m/1'", "m/0'/0'"},
+			want:  nil,
+		},
+		{
+			name:  "duplicates in different notations",
+			paths: []string{"m/0'", "m/1'", "m/0h", "m/1'", "m/00'"},
+			want:  []string{"m/0'", "m/1'"},
+		},
+		{
+			name:    "invalid path",
+			paths:   []string{"m/0'", "m/0"},
+			wantErr: ErrInvalidPath,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CheckUniquePaths(tt.paths)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckUniquePaths() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CheckUniquePaths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEstimateDerivations(t *testing.T) {
+	tests := []struct {
+		name    string
+		paths   []string
+		want    int
+		wantErr error
+	}{
+		{name: "none", paths: nil, want: 0},
+		{name: "master only", paths: []string{"m"}, want: 0},
+		{name: "single path", paths: []string{"m/44'/501'/0'"}, want: 3},
+		{
+			name:  "shared ancestors",
+			paths: []string{"m/44'/501'/0'", "m/44'/501'/1'", "m/44'/501'/2'/0'"},
+			want:  6,
+		},
+		{
+			name:  "duplicates in different notations",
+			paths: []string{"m/44'/501'", "m/44h/0501'", "m/44'"},
+			want:  2,
+		},
+		{
+			name:  "disjoint",
+			paths: []string{"m/0'/1'", "m/1'/0'"},
+			want:  4,
+		},
+		{
+			name:    "invalid path",
+			paths:   []string{"m/0'", "m/0"},
+			wantErr: ErrInvalidPath,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EstimateDerivations(tt.paths)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("EstimateDerivations() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("EstimateDerivations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAccountPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "m/44'/501'/0'", want: true},
+		{path: "m/44h/501H/7'", want: true},
+		{path: "m/86'/0'/2147483647'", want: true},
+		{path: "m/44'/501'", want: false},
+		{path: "m/44'/501'/0'/0'", want: false},
+		{path: "m/44'/501'/0", want: false},
+		{path: "m", want: false},
+		{path: "44'/501'/0'", want: false},
+		{path: "m/44'/501'/2147483648'", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := IsAccountPath(tt.path); got != tt.want {
+				t.Errorf("IsAccountPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPathRelation(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    string
+		want    Relation
+		wantErr error
+	}{
+		{name: "same", a: "m/44'/501'", b: "m/44h/501'", want: RelationSame},
+		{name: "same master", a: "m", b: "m", want: RelationSame},
+		{name: "ancestor", a: "m/44'", b: "m/44'/501'/0'", want: RelationAncestor},
+		{name: "master ancestor", a: "m", b: "m/0'", want: RelationAncestor},
+		{name: "descendant", a: "m/44'/501'/0'", b: "m/44'/501'", want: RelationDescendant},
+		{name: "sibling", a: "m/44'/501'/0'", b: "m/44'/501'/1'", want: RelationSibling},
+		{name: "top level siblings", a: "m/0'", b: "m/1'", want: RelationSibling},
+		{name: "segment boundary", a: "m/1'", b: "m/10'/0'", want: RelationUnrelated},
+		{name: "cousins", a: "m/44'/501'/0'", b: "m/44'/60'/0'", want: RelationUnrelated},
+		{name: "different depth", a: "m/0'/1'", b: "m/1'", want: RelationUnrelated},
+		{name: "invalid first", a: "m/0", b: "m/0'", wantErr: ErrInvalidPath},
+		{name: "invalid second", a: "m/0'", b: "0'", wantErr: ErrInvalidPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PathRelation(tt.a, tt.b)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PathRelation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PathRelation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePathLenient(t *testing.T) {
+	tests := []struct {
+		name        string
+		path        string
+		wantIndices []uint32
+		wantPath    string
+		wantErr     error
+	}{
+		{
+			name:        "canonical",
+			path:        "m/44'/501'",
+			wantIndices: []uint32{FirstHardenedIndex + 44, FirstHardenedIndex + 501},
+			wantPath:    "m/44'/501'",
+		},
+		{
+			name:        "electrum shorthand",
+			path:        "m/0h/1",
+			wantIndices: []uint32{FirstHardenedIndex, 1},
+			wantPath:    "m/0'/1",
+		},
+		{
+			name:        "no prefix, whitespace and trailing slash",
+			path:        " 44H/0'/1/ ",
+			wantIndices: []uint32{FirstHardenedIndex + 44, FirstHardenedIndex, 1},
+			wantPath:    "m/44'/0'/1",
+		},
+		{
+			name:        "uppercase master",
+			path:        "M",
+			wantIndices: []uint32{},
+			wantPath:    "m",
+		},
+		{name: "empty segment", path: "m//0'", wantErr: ErrInvalidPath},
+		{name: "double marker", path: "m/0'h", wantErr: ErrInvalidPath},
+		{name: "sign", path: "m/+1'", wantErr: ErrInvalidPath},
+		{name: "marker only", path: "m/'", wantErr: ErrInvalidPath},
+		{name: "overflow", path: "m/2147483648", wantErr: ErrInvalidPath},
+		{name: "nested master", path: "m/m/0'", wantErr: ErrInvalidPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indices, path, err := ParsePathLenient(tt.path)
+			if err != tt.wantErr {
+				t.Errorf("ParsePathLenient() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(indices, tt.wantIndices) {
+				t.Errorf("ParsePathLenient() indices = %v, want %v", indices, tt.wantIndices)
+			}
+			if path != tt.wantPath {
+				t.Errorf("ParsePathLenient() path = %v, want %v", path, tt.wantPath)
+			}
+		})
+	}
+}
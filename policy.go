@@ -0,0 +1,87 @@
+package slip10
+
+import "fmt"
+
+var (
+	ErrPathDenied = fmt.Errorf("path denied by policy")
+	ErrShallowKey = fmt.Errorf("key is too close to the master")
+)
+
+// PathPolicy restricts derivation to the subtrees of a set of allowed paths,
+// e.g. to keep every tenant of a signing service under its own m/44'/501'/<tenant>'.
+type PathPolicy struct {
+	allowed [][]uint32
+}
+
+// NewPathPolicy returns a policy that allows the given paths and all paths below them.
+// Paths may use any hardened notation accepted by CanonicalizePath.
+func NewPathPolicy(allowed []string) (*PathPolicy, error) {
+	p := &PathPolicy{allowed: make([][]uint32, 0, len(allowed))}
+	for _, path := range allowed {
+		indices, err := parsePath(path)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", err, path)
+		}
+		p.allowed = append(p.allowed, indices)
+	}
+
+	return p, nil
+}
+
+// Check returns nil if the path is one of the allowed paths or below one of them,
+// and an error wrapping ErrPathDenied otherwise. A nil policy denies every path.
+func (p *PathPolicy) Check(path string) error {
+	indices, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return fmt.Errorf("%w: no policy", ErrPathDenied)
+	}
+
+	for _, prefix := range p.allowed {
+		if isPathPrefix(prefix, indices) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %q is not under any allowed path", ErrPathDenied, path)
+}
+
+// DeriveForPathWithPolicy checks the path against the policy and then derives the key as DeriveForPath does.
+func DeriveForPathWithPolicy(path string, seed []byte, policy *PathPolicy) (Node, error) {
+	if err := policy.Check(path); err != nil {
+		return nil, err
+	}
+
+	return DeriveForPath(path, seed)
+}
+
+// RequireMinDepth returns an error wrapping ErrShallowKey if the node's depth is below minDepth,
+// so that signing endpoints can refuse keys too close to the master, e.g. with minDepth 4
+// nothing at the account level m/purpose'/coin'/account' or above signs.
+// The master node has depth 0, so a minDepth of 0 or less accepts every node.
+func (k *node) RequireMinDepth(minDepth int) error {
+	if k == nil {
+		return ErrNilNode
+	}
+	if int(k.depth) < minDepth {
+		return fmt.Errorf("%w: depth %d, want at least %d", ErrShallowKey, k.depth, minDepth)
+	}
+
+	return nil
+}
+
+// isPathPrefix reports whether prefix is the start of indices, or the same path.
+func isPathPrefix(prefix, indices []uint32) bool {
+	if len(prefix) > len(indices) {
+		return false
+	}
+	for i := range prefix {
+		if prefix[i] != indices[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,109 @@
+package slip10
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestPathPolicy_Check(t *testing.T) {
+	policy, err := NewPathPolicy([]string{"m/44'/501'/1'", "m/44h/501h/7h/0h"})
+	if err != nil {
+		t.Fatalf("NewPathPolicy() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr error
+	}{
+		{name: "allowed path", path: "m/44'/501'/1'"},
+		{name: "below allowed path", path: "m/44'/501'/1'/0'/5'"},
+		{name: "below allowed path in other notation", path: "m/44'/501'/7'/0'/0'"},
+		{name: "sibling tenant", path: "m/44'/501'/2'/0'", wantErr: ErrPathDenied},
+		{name: "tenant with common digits", path: "m/44'/501'/11'", wantErr: ErrPathDenied},
+		{name: "ancestor of allowed path", path: "m/44'/501'", wantErr: ErrPathDenied},
+		{name: "above a deeper allowed path", path: "m/44'/501'/7'", wantErr: ErrPathDenied},
+		{name: "master", path: "m", wantErr: ErrPathDenied},
+		{name: "invalid path", path: "m/44'/501'/1'/0", wantErr: ErrInvalidPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := policy.Check(tt.path); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Check() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewPathPolicy_Invalid(t *testing.T) {
+	if _, err := NewPathPolicy([]string{"m/44'", "m/44"}); !errors.Is(err, ErrInvalidPath) {
+		t.Errorf("NewPathPolicy() error = %v, wantErr %v", err, ErrInvalidPath)
+	}
+}
+
+func TestDeriveForPathWithPolicy(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	policy, err := NewPathPolicy([]string{"m/0'"})
+	if err != nil {
+		t.Fatalf("NewPathPolicy() error = %v", err)
+	}
+
+	got, err := DeriveForPathWithPolicy("m/0'/1'", seed, policy)
+	if err != nil {
+		t.Fatalf("DeriveForPathWithPolicy() error = %v", err)
+	}
+	want, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	if !bytes.Equal(got.RawSeed(), want.RawSeed()) {
+		t.Errorf("DeriveForPathWithPolicy() = %x, want %x", got.RawSeed(), want.RawSeed())
+	}
+
+	if _, err := DeriveForPathWithPolicy("m/1'/0'", seed, policy); !errors.Is(err, ErrPathDenied) {
+		t.Errorf("DeriveForPathWithPolicy() error = %v, wantErr %v", err, ErrPathDenied)
+	}
+}
+
+func TestPathPolicy_Nil(t *testing.T) {
+	var policy *PathPolicy
+
+	if err := policy.Check("m/0'"); !errors.Is(err, ErrPathDenied) {
+		t.Errorf("Check() error = %v, wantErr %v", err, ErrPathDenied)
+	}
+	if _, err := DeriveForPathWithPolicy("m/0'", hexMustDecode("000102030405060708090a0b0c0d0e0f"), nil); !errors.Is(err, ErrPathDenied) {
+		t.Errorf("DeriveForPathWithPolicy() error = %v, wantErr %v", err, ErrPathDenied)
+	}
+}
+
+func TestNode_RequireMinDepth(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	tests := []struct {
+		name     string
+		path     string
+		minDepth int
+		wantErr  error
+	}{
+		{name: "account below minDepth", path: "m/44'/501'/0'", minDepth: 4, wantErr: ErrShallowKey},
+		{name: "at minDepth", path: "m/44'/501'/0'/0'", minDepth: 4},
+		{name: "above minDepth", path: "m/44'/501'/0'/0'/0'", minDepth: 4},
+		{name: "master below minDepth", path: "m", minDepth: 1, wantErr: ErrShallowKey},
+		{name: "master at zero", path: "m", minDepth: 0},
+		{name: "negative minDepth", path: "m", minDepth: -1},
+		{name: "negative minDepth on an account", path: "m/44'/501'/0'", minDepth: -4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := DeriveForPath(tt.path, seed)
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+
+			if err := node.RequireMinDepth(tt.minDepth); !errors.Is(err, tt.wantErr) {
+				t.Errorf("RequireMinDepth() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
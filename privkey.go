@@ -0,0 +1,32 @@
+package slip10
+
+import (
+	"crypto/ed25519"
+	"crypto/subtle"
+	"fmt"
+)
+
+var ErrInvalidPrivateKey = fmt.Errorf("invalid private key")
+
+// VerifyPrivateKeyDerivation reports whether candidate is the private key derived for the path
+// from the seed, e.g. to check that an imported key is the expected one.
+// The candidate is either the 32-byte private key seed or the 64-byte ed25519 private key
+// (seed || public key); other lengths return ErrInvalidPrivateKey.
+// Keys are compared in constant time.
+func VerifyPrivateKeyDerivation(seed []byte, path string, candidate []byte) (bool, error) {
+	if len(candidate) != ed25519.SeedSize && len(candidate) != ed25519.PrivateKeySize {
+		return false, ErrInvalidPrivateKey
+	}
+
+	key, err := DeriveForPath(path, seed)
+	if err != nil {
+		return false, err
+	}
+
+	_, priv := key.Keypair()
+	want := []byte(priv)
+	if len(candidate) == ed25519.SeedSize {
+		want = priv.Seed()
+	}
+	return subtle.ConstantTimeCompare(candidate, want) == 1, nil
+}
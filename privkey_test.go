@@ -0,0 +1,46 @@
+package slip10
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestVerifyPrivateKeyDerivation(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	node, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	_, priv := node.Keypair()
+	tampered := append([]byte(nil), priv...)
+	tampered[63] ^= 0x01
+
+	type args struct {
+		path      string
+		candidate []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr error
+	}{
+		{name: "seed form", args: args{path: "m/0'/1'", candidate: priv.Seed()}, want: true},
+		{name: "ed25519 form", args: args{path: "m/0'/1'", candidate: priv}, want: true},
+		{name: "other path", args: args{path: "m/0'/2'", candidate: priv.Seed()}, want: false},
+		{name: "wrong public half", args: args{path: "m/0'/1'", candidate: tampered}, want: false},
+		{name: "wrong length", args: args{path: "m/0'/1'", candidate: priv[:31]}, wantErr: ErrInvalidPrivateKey},
+		{name: "invalid path", args: args{path: "m/0/1", candidate: priv.Seed()}, wantErr: ErrInvalidPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := VerifyPrivateKeyDerivation(seed, tt.args.path, tt.args.candidate)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VerifyPrivateKeyDerivation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("VerifyPrivateKeyDerivation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
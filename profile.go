@@ -0,0 +1,55 @@
+package slip10
+
+import "fmt"
+
+// DeviceProfile selects the conventions of a hardware wallet for DeriveForPathProfile.
+// No profile changes the derivation itself or the byte order of keys: the devices
+// implement slip-10 as well and encode ed25519 public keys as in RFC 8032.
+type DeviceProfile int
+
+const (
+	// ProfileSLIP10 follows the slip-10 test vectors: strict paths and public keys with the 0x00 prefix.
+	ProfileSLIP10 DeviceProfile = iota
+	// ProfileTrezor returns the bare 32-byte public key, as Trezor reports it for ed25519 coins.
+	// Paths are strict.
+	ProfileTrezor
+	// ProfileLedger returns the bare 32-byte public key, as Ledger apps report it for ed25519 coins.
+	// Paths may use the shorthand of ParsePathLenient and non-hardened segments are hardened,
+	// because Ledger ed25519 apps harden every segment of a path.
+	ProfileLedger
+)
+
+var ErrUnknownProfile = fmt.Errorf("unknown device profile")
+
+// DeriveForPathProfile derives key for a path and a seed following the device profile,
+// and returns the node with its public key in the format reported by the device.
+func DeriveForPathProfile(path string, seed []byte, profile DeviceProfile) (Node, []byte, error) {
+	var (
+		key Node
+		err error
+	)
+	switch profile {
+	case ProfileSLIP10, ProfileTrezor:
+		key, err = DeriveForPath(path, seed)
+	case ProfileLedger:
+		var indices []uint32
+		if indices, _, err = ParsePathLenient(path); err != nil {
+			return nil, nil, err
+		}
+		for i := range indices {
+			indices[i] |= FirstHardenedIndex
+		}
+		key, err = deriveIndices(seed, indices)
+	default:
+		return nil, nil, ErrUnknownProfile
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if profile == ProfileSLIP10 {
+		return key, key.PublicKeyWithPrefix(), nil
+	}
+	pub, _ := key.Keypair()
+	return key, pub, nil
+}
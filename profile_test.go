@@ -0,0 +1,64 @@
+package slip10
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDeriveForPathProfile(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	type args struct {
+		path    string
+		profile DeviceProfile
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantPub []byte
+		wantErr error
+	}{
+		{
+			name:    "slip-10",
+			args:    args{path: "m/0'/1'", profile: ProfileSLIP10},
+			wantPub: hexMustDecode("001932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"),
+		},
+		{
+			name:    "trezor",
+			args:    args{path: "m/0'/1'", profile: ProfileTrezor},
+			wantPub: hexMustDecode("1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"),
+		},
+		{
+			name:    "trezor non-hardened",
+			args:    args{path: "m/0'/1", profile: ProfileTrezor},
+			wantErr: ErrInvalidPath,
+		},
+		{
+			name:    "ledger",
+			args:    args{path: "m/0'/1'", profile: ProfileLedger},
+			wantPub: hexMustDecode("1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"),
+		},
+		{
+			name:    "ledger hardens every segment",
+			args:    args{path: "m/0/1h", profile: ProfileLedger},
+			wantPub: hexMustDecode("1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"),
+		},
+		{
+			name:    "unknown profile",
+			args:    args{path: "m/0'/1'", profile: DeviceProfile(-1)},
+			wantErr: ErrUnknownProfile,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, pub, err := DeriveForPathProfile(tt.args.path, seed, tt.args.profile)
+			if err != tt.wantErr {
+				t.Errorf("DeriveForPathProfile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !bytes.Equal(pub, tt.wantPub) {
+				t.Errorf("DeriveForPathProfile() pub = %X, want %X", pub, tt.wantPub)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package slip10
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+var ErrInvalidPublicKey = fmt.Errorf("invalid public key")
+
+// StripPublicPrefix turns a 33-byte public key as returned by PublicKeyWithPrefix
+// into the bare 32-byte ed25519 public key.
+// It returns ErrInvalidPublicKey if the key has a wrong length or a prefix other than 0x00.
+func StripPublicPrefix(pub []byte) ([]byte, error) {
+	if len(pub) != Ed25519PrefixedPubKeyLen || pub[0] != 0x00 {
+		return nil, ErrInvalidPublicKey
+	}
+
+	return append([]byte(nil), pub[1:]...), nil
+}
+
+// AddPublicPrefix turns a bare 32-byte ed25519 public key into the 33-byte form
+// with the 0x00 prefix, as returned by PublicKeyWithPrefix.
+// It returns nil if the key is not 32 bytes long.
+func AddPublicPrefix(pub []byte) []byte {
+	if len(pub) != Ed25519KeyLen {
+		return nil
+	}
+
+	return append([]byte{0x00}, pub...)
+}
+
+// SamePublic reports whether the node and other have the same public key,
+// i.e. represent the same account to anyone who only sees public data.
+// It compares public material only, so it is not constant-time and says nothing
+// about the chain codes: nodes with the same key but different chain codes derive different children.
+// A nil other, including a typed nil, is never the same.
+func (k *node) SamePublic(other Node) bool {
+	if k == nil {
+		return false
+	}
+	o, ok := other.(*node)
+	if !ok || o == nil {
+		return false
+	}
+
+	pub, _ := k.Keypair()
+	otherPub, _ := o.Keypair()
+	return len(pub) == Ed25519KeyLen && bytes.Equal(pub, otherPub)
+}
+
+// PublicKeyEncodings holds the node's public key in the encodings commonly shown by wallet UIs.
+type PublicKeyEncodings struct {
+	// Hex is the bare 32-byte public key in lowercase hex.
+	Hex string
+	// Base58 is the bare public key in base58, as Solana addresses are written.
+	Base58 string
+	// Base64 is the bare public key in standard padded base64.
+	Base64 string
+	// PrefixedHex is the 33-byte public key with the 0x00 prefix in lowercase hex,
+	// as returned by PublicKeyWithPrefix.
+	PrefixedHex string
+}
+
+// PublicKeyEncodings returns the node's public key in every encoding of PublicKeyEncodings,
+// computing the key once, so that a UI shows the same key consistently across formats.
+// It returns the zero value for nodes without a 32-byte private key.
+func (k *node) PublicKeyEncodings() PublicKeyEncodings {
+	pub, _, err := k.keypair()
+	if err != nil {
+		return PublicKeyEncodings{}
+	}
+	return PublicKeyEncodings{
+		Hex:         hex.EncodeToString(pub),
+		Base58:      base58Encode(pub),
+		Base64:      base64.StdEncoding.EncodeToString(pub),
+		PrefixedHex: "00" + hex.EncodeToString(pub),
+	}
+}
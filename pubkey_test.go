@@ -0,0 +1,136 @@
+package slip10
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestStripPublicPrefix(t *testing.T) {
+	tests := []struct {
+		name    string
+		pub     []byte
+		want    []byte
+		wantErr error
+	}{
+		{
+			name: "prefixed key",
+			pub:  hexMustDecode("001932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"),
+			want: hexMustDecode("1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"),
+		},
+		{
+			name:    "unexpected prefix",
+			pub:     hexMustDecode("021932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"),
+			wantErr: ErrInvalidPublicKey,
+		},
+		{
+			name:    "bare key",
+			pub:     hexMustDecode("1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"),
+			wantErr: ErrInvalidPublicKey,
+		},
+		{
+			name:    "empty",
+			pub:     nil,
+			wantErr: ErrInvalidPublicKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StripPublicPrefix(tt.pub)
+			if err != tt.wantErr {
+				t.Errorf("StripPublicPrefix() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("StripPublicPrefix() = %X, want %X", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddPublicPrefix(t *testing.T) {
+	tests := []struct {
+		name string
+		pub  []byte
+		want []byte
+	}{
+		{
+			name: "bare key",
+			pub:  hexMustDecode("1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"),
+			want: hexMustDecode("001932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"),
+		},
+		{
+			name: "already prefixed",
+			pub:  hexMustDecode("001932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"),
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AddPublicPrefix(tt.pub); !bytes.Equal(got, tt.want) {
+				t.Errorf("AddPublicPrefix() = %X, want %X", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNode_SamePublic(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	k, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	again, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	other, err := DeriveForPath("m/0'/2'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	key, _ := k.Subtree()
+	// the same key with another chain code and no origin
+	restored, err := NewNodeFromSubtree(key, make([]byte, ChainCodeLen), 0, CurveEd25519)
+	if err != nil {
+		t.Fatalf("NewNodeFromSubtree() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		other Node
+		want  bool
+	}{
+		{name: "same path", other: again, want: true},
+		{name: "same key from elsewhere", other: restored, want: true},
+		{name: "other path", other: other, want: false},
+		{name: "nil", other: nil, want: false},
+		{name: "typed nil", other: (*node)(nil), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := k.SamePublic(tt.other); got != tt.want {
+				t.Errorf("SamePublic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNode_PublicKeyEncodings(t *testing.T) {
+	node, err := DeriveForPath("m/0'/1'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	want := PublicKeyEncodings{
+		Hex:         "1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187",
+		Base58:      "2hMz2f8WbLw5m2icKR2WVrcizvnguw8xaAnXjaeohuHQ",
+		Base64:      "GTKlJw8zW+1hfVuTXICu2xo1vZ/B4xrK/VNyww9cEYc=",
+		PrefixedHex: "001932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187",
+	}
+	if got := node.PublicKeyEncodings(); got != want {
+		t.Errorf("PublicKeyEncodings() = %+v, want %+v", got, want)
+	}
+	if got, want := node.PublicKeyEncodings().PrefixedHex, hex.EncodeToString(node.PublicKeyWithPrefix()); got != want {
+		t.Errorf("PublicKeyEncodings().PrefixedHex = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,62 @@
+package slip10
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Purpose values of the first path segment, which select the wallet type.
+const (
+	// PurposeBIP44 is for legacy multi-account wallets, https://github.com/bitcoin/bips/blob/master/bip-0044.mediawiki
+	PurposeBIP44 = uint32(44)
+	// PurposeBIP49 is for nested SegWit wallets, https://github.com/bitcoin/bips/blob/master/bip-0049.mediawiki
+	PurposeBIP49 = uint32(49)
+	// PurposeBIP84 is for native SegWit wallets, https://github.com/bitcoin/bips/blob/master/bip-0084.mediawiki
+	PurposeBIP84 = uint32(84)
+	// PurposeBIP86 is for Taproot wallets, https://github.com/bitcoin/bips/blob/master/bip-0086.mediawiki
+	PurposeBIP86 = uint32(86)
+)
+
+// accountDepth is the depth of account nodes m/purpose'/coin'/account'.
+const accountDepth = 3
+
+var (
+	ErrNotAccountNode    = fmt.Errorf("node is not an account node")
+	ErrInvalidCoinSymbol = fmt.Errorf("invalid coin symbol")
+)
+
+// DeriveForPurpose derives key for the standard five-level path
+// m/purpose'/coin'/account'/change'/index' and a seed.
+// Ed25519 derivation operates on hardened keys only, so unlike the secp256k1 wallets
+// of BIP-44 and its successors the change and index levels are hardened as well.
+// Every value must be a child number below 2^31, otherwise ErrInvalidIndex is returned.
+func DeriveForPurpose(seed []byte, purpose, coin, account, change, index uint32) (Node, error) {
+	indices := []uint32{purpose, coin, account, change, index}
+	for i := range indices {
+		if indices[i] >= FirstHardenedIndex {
+			return nil, ErrInvalidIndex
+		}
+		indices[i] += FirstHardenedIndex
+	}
+
+	return deriveIndices(seed, indices)
+}
+
+// AccountLabel returns a label for an account node to show in wallet UIs, e.g. "Solana Account 1":
+// the coin symbol, "Account" and the account number counted from 1, so that account 0' is "Account 1".
+// It returns ErrNotAccountNode if the node is not at the depth of m/purpose'/coin'/account'
+// or its child number is unknown, as for nodes restored with NewNodeFromSubtree,
+// and ErrInvalidCoinSymbol for an empty coin symbol.
+func (k *node) AccountLabel(coinSymbol string) (string, error) {
+	if k == nil {
+		return "", ErrNilNode
+	}
+	if k.depth != accountDepth || k.index < FirstHardenedIndex {
+		return "", ErrNotAccountNode
+	}
+	if coinSymbol == "" {
+		return "", ErrInvalidCoinSymbol
+	}
+
+	return coinSymbol + " Account " + strconv.FormatUint(uint64(k.index-FirstHardenedIndex)+1, 10), nil
+}
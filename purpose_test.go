@@ -0,0 +1,104 @@
+package slip10
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestDeriveForPurpose(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	type args struct {
+		purpose, coin, account, change, index uint32
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantPath string
+		wantErr  error
+	}{
+		{name: "BIP44", args: args{PurposeBIP44, 501, 0, 0, 0}, wantPath: "m/44'/501'/0'/0'/0'"},
+		{name: "BIP49", args: args{PurposeBIP49, 0, 1, 0, 2}, wantPath: "m/49'/0'/1'/0'/2'"},
+		{name: "BIP84", args: args{PurposeBIP84, 0, 0, 1, 5}, wantPath: "m/84'/0'/0'/1'/5'"},
+		{name: "BIP86", args: args{PurposeBIP86, 0, 3, 0, 7}, wantPath: "m/86'/0'/3'/0'/7'"},
+		{name: "hardened index", args: args{PurposeBIP44, 501, 0, 0, FirstHardenedIndex}, wantErr: ErrInvalidIndex},
+		{name: "hardened purpose", args: args{PurposeBIP44 + FirstHardenedIndex, 501, 0, 0, 0}, wantErr: ErrInvalidIndex},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeriveForPurpose(seed, tt.args.purpose, tt.args.coin, tt.args.account, tt.args.change, tt.args.index)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DeriveForPurpose() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			want, err := DeriveForPath(tt.wantPath, seed)
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+			if !bytes.Equal(got.RawSeed(), want.RawSeed()) {
+				t.Errorf("DeriveForPurpose() = %x, want %x", got.RawSeed(), want.RawSeed())
+			}
+			path, err := got.Path()
+			if err != nil {
+				t.Fatalf("Path() error = %v", err)
+			}
+			if path != tt.wantPath {
+				t.Errorf("DeriveForPurpose().Path() = %v, want %v", path, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestNode_AccountLabel(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	tests := []struct {
+		name       string
+		path       string
+		coinSymbol string
+		want       string
+		wantErr    error
+	}{
+		{name: "first account", path: "m/44'/501'/0'", coinSymbol: "Solana", want: "Solana Account 1"},
+		{name: "max account", path: "m/44'/501'/2147483647'", coinSymbol: "SOL", want: "SOL Account 2147483648"},
+		{name: "coin node", path: "m/44'/501'", coinSymbol: "Solana", wantErr: ErrNotAccountNode},
+		{name: "address node", path: "m/44'/501'/0'/0'", coinSymbol: "Solana", wantErr: ErrNotAccountNode},
+		{name: "master", path: "m", coinSymbol: "Solana", wantErr: ErrNotAccountNode},
+		{name: "empty coin symbol", path: "m/44'/501'/0'", wantErr: ErrInvalidCoinSymbol},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := DeriveForPath(tt.path, seed)
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+
+			got, err := node.AccountLabel(tt.coinSymbol)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AccountLabel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("AccountLabel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNode_AccountLabel_UnknownIndex(t *testing.T) {
+	account, err := DeriveForPath("m/44'/501'/0'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	restored, err := NewNodeFromSubtree(account.(*node).key, account.(*node).chainCode, 3, CurveEd25519)
+	if err != nil {
+		t.Fatalf("NewNodeFromSubtree() error = %v", err)
+	}
+
+	if _, err := restored.AccountLabel("Solana"); err != ErrNotAccountNode {
+		t.Errorf("AccountLabel() error = %v, wantErr %v", err, ErrNotAccountNode)
+	}
+}
@@ -0,0 +1,37 @@
+package slip10
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestRipemd160Sum(t *testing.T) {
+	// test vectors from https://homes.esat.kuleuven.be/~bosselae/ripemd160.html
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{name: "empty", data: "", want: "9c1185a5c5e9fc54612808977ee8f548b2258d31"},
+		{name: "a", data: "a", want: "0bdc9d2d256b3ee9daae347be6f4dc835a467ffe"},
+		{name: "abc", data: "abc", want: "8eb208f7e05d987a9b044a8e98c6b087f15a0bfc"},
+		{name: "message digest", data: "message digest", want: "5d0689ef49d2fae572b881b123a85ffa21595f36"},
+		{name: "alphabet", data: "abcdefghijklmnopqrstuvwxyz", want: "f71c27109c692c1b56bbdceb5b9d2865b3708dbc"},
+		{
+			name: "two blocks",
+			data: "abcdbcdecdefdefgefghfghighijhijkijkljklmklmnlmnomnopnopq",
+			want: "12a053384a9c0c88e405a06c27dcf49ada62eb2b",
+		},
+		{name: "digits", data: strings.Repeat("1234567890", 8), want: "9b752e45573d4b39f4dbd3323cab82bf63326bfb"},
+		{name: "million a", data: strings.Repeat("a", 1000000), want: "52783243c1697bdbe16d37f97f68f08325dc1528"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ripemd160Sum([]byte(tt.data))
+			if hex.EncodeToString(got[:]) != tt.want {
+				t.Errorf("ripemd160Sum() = %x, want %s", got, tt.want)
+			}
+		})
+	}
+}
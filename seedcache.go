@@ -0,0 +1,72 @@
+package slip10
+
+import (
+	"crypto/sha256"
+	"sync"
+)
+
+// seedCache holds the master nodes of DeriveForPathCached, keyed by SHA-256 of their seed.
+// Derivations hold the read lock while they use a cached node, so that ClearSeedCache
+// does not wipe it under them.
+var seedCache = struct {
+	mu      sync.RWMutex
+	masters map[[sha256.Size]byte]*node
+}{masters: make(map[[sha256.Size]byte]*node)}
+
+// DeriveForPathCached derives key for a path and a seed as DeriveForPath does,
+// but keeps the master node of the seed in a package cache, so that further calls
+// with the same seed skip the master HMAC. Paths are validated as by DeriveForPath,
+// with apostrophes as the only hardened markers. It is safe for concurrent use.
+// The cache trades memory hygiene for speed: the master node, from which every key of
+// the wallet can be derived, and the SHA-256 of the seed stay in memory until ClearSeedCache,
+// for every seed ever passed. Use a Deriver to bound the lifetime of a single master node instead.
+func DeriveForPathCached(path string, seed []byte) (Node, error) {
+	indices, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	if !pathRegex.MatchString(path) {
+		return nil, ErrInvalidPath
+	}
+
+	id := sha256.Sum256(seed)
+	for {
+		seedCache.mu.RLock()
+		if master, ok := seedCache.masters[id]; ok {
+			defer seedCache.mu.RUnlock()
+			if len(indices) == 0 {
+				return master.detached(), nil
+			}
+			return deriveFrom(master, indices)
+		}
+		seedCache.mu.RUnlock()
+
+		master, err := NewMasterNode(seed)
+		if err != nil {
+			return nil, err
+		}
+		seedCache.mu.Lock()
+		if _, ok := seedCache.masters[id]; ok {
+			master.(*node).wipe()
+		} else {
+			seedCache.masters[id] = master.(*node)
+		}
+		seedCache.mu.Unlock()
+	}
+}
+
+// ClearSeedCache zeroes the key and chain code of every master node cached by DeriveForPathCached
+// and empties the cache. It is safe for concurrent use.
+// Nodes derived before keep their own keys but no copy of the master, except the copies
+// of the master returned for the path "m", which the caller wipes. The SHA-256 of every
+// seed was a map key and Go cannot zero map keys, so the hashes stay in the released
+// map memory until the garbage collector reuses it.
+func ClearSeedCache() {
+	seedCache.mu.Lock()
+	defer seedCache.mu.Unlock()
+
+	for _, master := range seedCache.masters {
+		master.wipe()
+	}
+	seedCache.masters = make(map[[sha256.Size]byte]*node)
+}
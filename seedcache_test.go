@@ -0,0 +1,112 @@
+package slip10
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestDeriveForPathCached(t *testing.T) {
+	t.Cleanup(ClearSeedCache)
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	for _, path := range []string{"m/0'/1'", "m/0'/2'", "m"} {
+		got, err := DeriveForPathCached(path, seed)
+		if err != nil {
+			t.Fatalf("DeriveForPathCached() error = %v", err)
+		}
+		want, err := DeriveForPath(path, seed)
+		if err != nil {
+			t.Fatalf("DeriveForPath() error = %v", err)
+		}
+		if !bytes.Equal(got.HMACOutput(), want.HMACOutput()) {
+			t.Errorf("DeriveForPathCached(%q) = %x, want %x", path, got.HMACOutput(), want.HMACOutput())
+		}
+	}
+
+	seedCache.mu.RLock()
+	master, ok := seedCache.masters[sha256.Sum256(seed)]
+	size := len(seedCache.masters)
+	seedCache.mu.RUnlock()
+	if !ok || size != 1 {
+		t.Fatalf("seed cache has %d masters, want the master of the seed only", size)
+	}
+
+	derived, err := DeriveForPathCached("m", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPathCached() error = %v", err)
+	}
+	want := append([]byte(nil), derived.HMACOutput()...)
+	child, err := DeriveForPathCached("m/0'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPathCached() error = %v", err)
+	}
+	masterKey, masterChainCode := master.Subtree()
+
+	ClearSeedCache()
+	if !bytes.Equal(master.key, make([]byte, Ed25519KeyLen)) || !bytes.Equal(master.chainCode, make([]byte, ChainCodeLen)) {
+		t.Errorf("cached master after ClearSeedCache = %x %x, want zeroes", master.key, master.chainCode)
+	}
+	if !bytes.Equal(derived.HMACOutput(), want) {
+		t.Errorf("derived node changed after ClearSeedCache")
+	}
+	// a depth 1 node holds no copy of the cached master
+	if k := child.(*node); k.ancestors != nil {
+		t.Errorf("depth 1 node keeps %d ancestors", len(k.ancestors))
+	}
+	for _, b := range [][]byte{child.RawSeed(), child.(*node).chainCode} {
+		if bytes.Equal(b, masterKey) || bytes.Equal(b, masterChainCode) {
+			t.Errorf("depth 1 node holds master key material after ClearSeedCache")
+		}
+	}
+	seedCache.mu.RLock()
+	size = len(seedCache.masters)
+	seedCache.mu.RUnlock()
+	if size != 0 {
+		t.Errorf("seed cache has %d masters after ClearSeedCache, want 0", size)
+	}
+
+	for _, path := range []string{"m/0", "m/0h", "m/0H/1'", " m/0'"} {
+		if _, err := DeriveForPathCached(path, seed); err != ErrInvalidPath {
+			t.Errorf("DeriveForPathCached(%q) error = %v, wantErr %v", path, err, ErrInvalidPath)
+		}
+	}
+	if _, err := DeriveForPathCached("m"+strings.Repeat("/0'", MaxPathDepth+1), seed); err != ErrPathTooDeep {
+		t.Errorf("DeriveForPathCached() error = %v, wantErr %v", err, ErrPathTooDeep)
+	}
+	if _, err := DeriveForPathCached("m/0'", nil); err != ErrInvalidSeedLength {
+		t.Errorf("DeriveForPathCached() error = %v, wantErr %v", err, ErrInvalidSeedLength)
+	}
+}
+
+func TestDeriveForPathCached_Concurrent(t *testing.T) {
+	t.Cleanup(ClearSeedCache)
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	want, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			got, err := DeriveForPathCached("m/0'/1'", seed)
+			if err != nil {
+				t.Errorf("DeriveForPathCached() error = %v", err)
+				return
+			}
+			if !bytes.Equal(got.HMACOutput(), want.HMACOutput()) {
+				t.Errorf("DeriveForPathCached() = %x, want %x", got.HMACOutput(), want.HMACOutput())
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			ClearSeedCache()
+		}()
+	}
+	wg.Wait()
+}
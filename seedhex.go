@@ -0,0 +1,53 @@
+package slip10
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// SeedFromHex decodes a seed pasted by a user as hex, before it is passed to NewMasterNode.
+// It accepts an optional "0x" or "0X" prefix, whitespace anywhere, e.g. between groups of digits,
+// and upper or lower case digits. The decoded seed must be MinSeedLength to MaxSeedLength bytes long.
+// It returns an error wrapping ErrInvalidSeed that says what is wrong with the input,
+// or one wrapping ErrInvalidSeedLength for a seed of the wrong length.
+func SeedFromHex(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		s = s[2:]
+	}
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+
+	if s == "" {
+		return nil, fmt.Errorf("%w: no hex digits", ErrInvalidSeed)
+	}
+	for i, r := range s {
+		if !isHexDigit(r) {
+			return nil, fmt.Errorf("%w: %q at position %d is not a hex digit", ErrInvalidSeed, r, i)
+		}
+	}
+	if len(s)%2 != 0 {
+		return nil, fmt.Errorf("%w: odd number of hex digits, %d", ErrInvalidSeed, len(s))
+	}
+
+	seed, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSeed, err)
+	}
+	if len(seed) < MinSeedLength || len(seed) > MaxSeedLength {
+		clear(seed)
+		return nil, fmt.Errorf("%w: %d bytes, want %d to %d", ErrInvalidSeedLength, len(seed), MinSeedLength, MaxSeedLength)
+	}
+
+	return seed, nil
+}
+
+func isHexDigit(r rune) bool {
+	return r >= '0' && r <= '9' || r >= 'a' && r <= 'f' || r >= 'A' && r <= 'F'
+}
@@ -0,0 +1,42 @@
+package slip10
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSeedFromHex(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	tests := []struct {
+		name    string
+		s       string
+		want    []byte
+		wantErr error
+	}{
+		{name: "plain", s: "000102030405060708090a0b0c0d0e0f", want: seed},
+		{name: "prefix", s: "0x000102030405060708090a0b0c0d0e0f", want: seed},
+		{name: "uppercase prefix and digits", s: "0X000102030405060708090A0B0C0D0E0F", want: seed},
+		{name: "whitespace", s: "  0x0001 0203 0405 0607\n0809 0a0b\t0c0d 0e0f\n", want: seed},
+		{name: "empty", s: "", wantErr: ErrInvalidSeed},
+		{name: "prefix only", s: "0x", wantErr: ErrInvalidSeed},
+		{name: "odd length", s: "000102030405060708090a0b0c0d0e0f0", wantErr: ErrInvalidSeed},
+		{name: "not hex", s: "000102030405060708090a0b0c0d0e0g", wantErr: ErrInvalidSeed},
+		{name: "prefix in the middle", s: "00010203040506070x8090a0b0c0d0e0f", wantErr: ErrInvalidSeed},
+		{name: "too short", s: "000102030405060708090a0b0c0d0e", wantErr: ErrInvalidSeedLength},
+		{name: "too long", s: "0x" + strings.Repeat("ab", 65), wantErr: ErrInvalidSeedLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SeedFromHex(tt.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SeedFromHex() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("SeedFromHex() = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
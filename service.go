@@ -0,0 +1,66 @@
+package slip10
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+var ErrInvalidSeed = fmt.Errorf("invalid seed")
+
+// DeriveRequest is the input of a derivation endpoint.
+// Its validate tags are for github.com/go-playground/validator and its jsonschema tags
+// for github.com/invopop/jsonschema, so that endpoints can reject bad requests before Handle,
+// which checks everything again.
+type DeriveRequest struct {
+	// Path is a derivation path in the format of DeriveForPath, e.g. "m/44'/501'/0'".
+	Path string `json:"path" validate:"required" jsonschema:"required,pattern=^m(/[0-9]+')*$"`
+	// Curve is the name of the curve, "ed25519".
+	Curve string `json:"curve" validate:"required,oneof=ed25519" jsonschema:"required,enum=ed25519"`
+	// SeedHex is the hex encoded seed of 16 to 64 bytes, checked as by DeriveForPathStrict.
+	SeedHex string `json:"seedHex" validate:"required,min=32,max=128" jsonschema:"required,pattern=^([0-9a-fA-F]{2})+$,minLength=32,maxLength=128"`
+}
+
+// DeriveResponse is the output of a derivation endpoint. It holds public data only.
+type DeriveResponse struct {
+	// PublicKey is the hex encoded bare 32-byte public key.
+	PublicKey string `json:"publicKey" validate:"required,len=64" jsonschema:"required,pattern=^[0-9a-f]{64}$"`
+	// Fingerprint is the hex encoded fingerprint of the node.
+	Fingerprint string `json:"fingerprint" validate:"required,len=8" jsonschema:"required,pattern=^[0-9a-f]{8}$"`
+	// Path is the canonical form of the requested path.
+	Path  string `json:"path" validate:"required" jsonschema:"required,pattern=^m(/[0-9]+')*$"`
+	Curve string `json:"curve" validate:"required,oneof=ed25519" jsonschema:"required,enum=ed25519"`
+}
+
+// Handle validates a derivation request, derives the key with the checks of DeriveForPathStrict
+// and returns its public data, for use in HTTP derivation endpoints.
+// The response never contains the seed or a private key, and the decoded seed and the derived key are wiped.
+func Handle(req DeriveRequest) (DeriveResponse, error) {
+	curve, err := parseCurve(req.Curve)
+	if err != nil {
+		return DeriveResponse{}, err
+	}
+	seed, err := hex.DecodeString(req.SeedHex)
+	if err != nil {
+		return DeriveResponse{}, ErrInvalidSeed
+	}
+	defer clear(seed)
+
+	key, err := DeriveForPathStrict(req.Path, seed)
+	if err != nil {
+		return DeriveResponse{}, err
+	}
+	defer key.(*node).wipe()
+	path, err := key.Path()
+	if err != nil {
+		return DeriveResponse{}, err
+	}
+	pub, _ := key.Keypair()
+	fingerprint := key.Fingerprint()
+
+	return DeriveResponse{
+		PublicKey:   hex.EncodeToString(pub),
+		Fingerprint: hex.EncodeToString(fingerprint[:]),
+		Path:        path,
+		Curve:       curve.String(),
+	}, nil
+}
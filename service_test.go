@@ -0,0 +1,117 @@
+package slip10
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestHandle(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     DeriveRequest
+		want    DeriveResponse
+		wantErr error
+	}{
+		{
+			name: "Key(m/0'/1')",
+			req:  DeriveRequest{Path: "m/0'/1'", Curve: "ed25519", SeedHex: "000102030405060708090a0b0c0d0e0f"},
+			want: DeriveResponse{
+				PublicKey:   "1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187",
+				Fingerprint: "ebe4cb29",
+				Path:        "m/0'/1'",
+				Curve:       "ed25519",
+			},
+		},
+		{
+			name:    "unknown curve",
+			req:     DeriveRequest{Path: "m/0'", Curve: "secp256k1", SeedHex: "000102030405060708090a0b0c0d0e0f"},
+			wantErr: ErrUnknownCurve,
+		},
+		{
+			name:    "seed not hex",
+			req:     DeriveRequest{Path: "m/0'", Curve: "ed25519", SeedHex: "zz0102030405060708090a0b0c0d0e0f"},
+			wantErr: ErrInvalidSeed,
+		},
+		{
+			name:    "short seed",
+			req:     DeriveRequest{Path: "m/0'", Curve: "ed25519", SeedHex: "0001020304050607"},
+			wantErr: ErrInvalidSeedLength,
+		},
+		{
+			name:    "invalid path",
+			req:     DeriveRequest{Path: "m/0", Curve: "ed25519", SeedHex: "000102030405060708090a0b0c0d0e0f"},
+			wantErr: ErrInvalidPath,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Handle(tt.req)
+			if err != tt.wantErr {
+				t.Fatalf("Handle() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Handle() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandle_NoSecrets(t *testing.T) {
+	seedHex := "000102030405060708090a0b0c0d0e0f"
+	resp, err := Handle(DeriveRequest{Path: "m/0'", Curve: "ed25519", SeedHex: seedHex})
+	if err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	out, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	// seed and private key of m/0'
+	for _, secret := range []string{seedHex, "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3"} {
+		if strings.Contains(string(out), secret) {
+			t.Errorf("Handle() response %s contains a secret", out)
+		}
+	}
+}
+
+func TestDeriveRequest_SchemaPatterns(t *testing.T) {
+	req := DeriveRequest{Path: "m/0'/1'", Curve: "ed25519", SeedHex: "000102030405060708090a0b0c0d0e0f"}
+	resp, err := Handle(req)
+	if err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+
+	// the jsonschema patterns must accept what Handle accepts and returns
+	for _, v := range []any{req, resp} {
+		value := reflect.ValueOf(v)
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if pattern := schemaPattern(field); pattern != nil && !pattern.MatchString(value.Field(i).String()) {
+				t.Errorf("%s.%s = %q does not match %s", value.Type().Name(), field.Name, value.Field(i).String(), pattern)
+			}
+		}
+	}
+
+	// and reject what Handle rejects
+	reqType := reflect.TypeOf(DeriveRequest{})
+	for name, bad := range map[string]string{"Path": "m/0", "SeedHex": "zz0102030405060708090a0b0c0d0e0f"} {
+		field, _ := reqType.FieldByName(name)
+		if schemaPattern(field).MatchString(bad) {
+			t.Errorf("DeriveRequest.%s pattern accepts %q", name, bad)
+		}
+	}
+}
+
+// schemaPattern returns the pattern of the field's jsonschema tag, or nil if it has none.
+func schemaPattern(field reflect.StructField) *regexp.Regexp {
+	for _, option := range strings.Split(field.Tag.Get("jsonschema"), ",") {
+		if pattern, ok := strings.CutPrefix(option, "pattern="); ok {
+			return regexp.MustCompile(pattern)
+		}
+	}
+	return nil
+}
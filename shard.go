@@ -0,0 +1,36 @@
+package slip10
+
+import "fmt"
+
+// DeriveShard derives the children of the node with indices in [start, end) and calls fn
+// with each index and child in order, stopping at the first error fn returns.
+// The receiver is the shared parent node, so workers can split an index range between them
+// without building a slice of all children.
+// If hardened is set, start and end are child numbers below 2^31 and FirstHardenedIndex is
+// added to each of them, as for "0'"; otherwise they are raw indices, and ed25519 accepts
+// only raw indices from FirstHardenedIndex on.
+func (k *node) DeriveShard(start, end uint32, hardened bool, fn func(index uint32, node Node) error) error {
+	if k == nil {
+		return fmt.Errorf("%w: %w", ErrCannotDerive, ErrNilNode)
+	}
+	if start > end || (hardened && end > FirstHardenedIndex) {
+		return ErrInvalidIndex
+	}
+
+	for i := start; i < end; i++ {
+		index := i
+		if hardened {
+			index += FirstHardenedIndex
+		}
+
+		child, err := k.Derive(index)
+		if err != nil {
+			return err
+		}
+		if err := fn(i, child); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package slip10
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestNode_DeriveShard(t *testing.T) {
+	master, err := NewMasterNode(hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("NewMasterNode() error = %v", err)
+	}
+	errStop := errors.New("stop")
+
+	tests := []struct {
+		name        string
+		start, end  uint32
+		hardened    bool
+		stopAt      int
+		wantIndices []uint32
+		wantErr     error
+	}{
+		{name: "hardened", start: 0, end: 3, hardened: true, wantIndices: []uint32{0, 1, 2}},
+		{name: "raw", start: FirstHardenedIndex + 1, end: FirstHardenedIndex + 3, wantIndices: []uint32{FirstHardenedIndex + 1, FirstHardenedIndex + 2}},
+		{name: "empty", start: 5, end: 5, hardened: true},
+		{name: "last hardened", start: FirstHardenedIndex - 1, end: FirstHardenedIndex, hardened: true, wantIndices: []uint32{FirstHardenedIndex - 1}},
+		{name: "callback error", start: 0, end: 10, hardened: true, stopAt: 2, wantIndices: []uint32{0, 1}, wantErr: errStop},
+		{name: "start after end", start: 3, end: 1, hardened: true, wantErr: ErrInvalidIndex},
+		{name: "hardened out of range", start: 0, end: FirstHardenedIndex + 1, hardened: true, wantErr: ErrInvalidIndex},
+		{name: "raw non-hardened", start: 0, end: 1, wantErr: ErrNoPublicDerivation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var indices []uint32
+			err := master.DeriveShard(tt.start, tt.end, tt.hardened, func(index uint32, child Node) error {
+				if tt.stopAt > 0 && len(indices) == tt.stopAt {
+					return errStop
+				}
+				indices = append(indices, index)
+
+				raw := index
+				if tt.hardened {
+					raw += FirstHardenedIndex
+				}
+				want, err := master.Derive(raw)
+				if err != nil {
+					t.Fatalf("Derive() error = %v", err)
+				}
+				if !bytes.Equal(child.RawSeed(), want.RawSeed()) {
+					t.Errorf("DeriveShard() child %d = %x, want %x", index, child.RawSeed(), want.RawSeed())
+				}
+				return nil
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DeriveShard() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(indices) != len(tt.wantIndices) {
+				t.Fatalf("DeriveShard() visited %v, want %v", indices, tt.wantIndices)
+			}
+			for n := range indices {
+				if indices[n] != tt.wantIndices[n] {
+					t.Errorf("DeriveShard() visited %v, want %v", indices, tt.wantIndices)
+				}
+			}
+		})
+	}
+}
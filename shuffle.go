@@ -0,0 +1,57 @@
+package slip10
+
+import (
+	"crypto/hkdf"
+	"crypto/sha256"
+	"fmt"
+	"math/rand/v2"
+)
+
+// shuffleSalt separates shuffle keys from other keys derived with HKDF.
+const shuffleSalt = "slip10 shuffle"
+
+var ErrInvalidShuffleSize = fmt.Errorf("invalid shuffle size")
+
+// DeterministicShuffle returns a permutation of the integers 0 to n-1 for reproducible selection,
+// e.g. of a validator: a Fisher-Yates shuffle driven by ChaCha8 keyed with HKDF-SHA256
+// of the node's private key, using seed as info. Draws are unbiased and the algorithm is fixed,
+// so the same node, n and seed always give the same permutation across versions.
+// Anyone holding the node's private key can reproduce, and so predict, the permutation.
+// It returns ErrInvalidShuffleSize for a negative n.
+func (k *node) DeterministicShuffle(n int, seed []byte) ([]int, error) {
+	if k == nil {
+		return nil, ErrNilNode
+	}
+	if n < 0 {
+		return nil, ErrInvalidShuffleSize
+	}
+
+	key, err := hkdf.Key(sha256.New, k.key, []byte(shuffleSalt), string(seed), 32)
+	if err != nil {
+		return nil, err
+	}
+	rng := rand.NewChaCha8([32]byte(key))
+	clear(key)
+
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	for i := n - 1; i > 0; i-- {
+		j := uniformUint64(rng, uint64(i)+1)
+		perm[i], perm[j] = perm[j], perm[i]
+	}
+	return perm, nil
+}
+
+// uniformUint64 returns a uniform random number in [0, bound) by rejection sampling,
+// so that the result does not depend on the algorithms of math/rand.
+func uniformUint64(rng *rand.ChaCha8, bound uint64) uint64 {
+	// reject the lowest 2^64 mod bound values so the rest split evenly
+	threshold := -bound % bound
+	for {
+		if v := rng.Uint64(); v >= threshold {
+			return v % bound
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package slip10
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestNode_DeterministicShuffle(t *testing.T) {
+	node, err := DeriveForPath("m/0'/1'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	// pinned so that a change of the algorithm is noticed: permutations must be stable across versions
+	got, err := node.DeterministicShuffle(10, []byte("epoch 1"))
+	if err != nil {
+		t.Fatalf("DeterministicShuffle() error = %v", err)
+	}
+	if want := []int{8, 0, 5, 2, 4, 6, 3, 7, 9, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DeterministicShuffle() = %v, want %v", got, want)
+	}
+
+	again, err := node.DeterministicShuffle(10, []byte("epoch 1"))
+	if err != nil {
+		t.Fatalf("DeterministicShuffle() error = %v", err)
+	}
+	if !reflect.DeepEqual(again, got) {
+		t.Errorf("DeterministicShuffle() is not deterministic: %v, then %v", got, again)
+	}
+
+	other, err := node.DeterministicShuffle(10, []byte("epoch 2"))
+	if err != nil {
+		t.Fatalf("DeterministicShuffle() error = %v", err)
+	}
+	if reflect.DeepEqual(other, got) {
+		t.Errorf("DeterministicShuffle() gives the same permutation for another seed: %v", other)
+	}
+}
+
+func TestNode_DeterministicShuffle_Sizes(t *testing.T) {
+	node, err := DeriveForPath("m/0'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	for _, n := range []int{0, 1, 2, 1000} {
+		perm, err := node.DeterministicShuffle(n, nil)
+		if err != nil {
+			t.Fatalf("DeterministicShuffle(%d) error = %v", n, err)
+		}
+		sorted := slices.Sorted(slices.Values(perm))
+		for i, v := range sorted {
+			if v != i {
+				t.Fatalf("DeterministicShuffle(%d) = %v, not a permutation", n, perm)
+			}
+		}
+		if len(perm) != n {
+			t.Errorf("DeterministicShuffle(%d) has %d elements", n, len(perm))
+		}
+	}
+
+	if _, err := node.DeterministicShuffle(-1, nil); err != ErrInvalidShuffleSize {
+		t.Errorf("DeterministicShuffle() error = %v, wantErr %v", err, ErrInvalidShuffleSize)
+	}
+}
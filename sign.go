@@ -0,0 +1,102 @@
+package slip10
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"sync"
+)
+
+// SigningFunc returns sign, which signs messages with the node's ed25519 private key,
+// and cleanup, which wipes the copy of the key held by sign. After cleanup, sign returns nil.
+// It lets callers hand a signer with a bounded lifetime to other code without the node or the raw key.
+// The node itself is left untouched. Both functions are safe for concurrent use.
+// For nodes without a 32-byte private key and for wiped nodes, sign always returns nil.
+func (k *node) SigningFunc() (sign func(msg []byte) []byte, cleanup func()) {
+	_, priv, _ := k.signingKeypair()
+
+	var mu sync.Mutex
+	sign = func(msg []byte) []byte {
+		mu.Lock()
+		defer mu.Unlock()
+		if priv == nil {
+			return nil
+		}
+		return ed25519.Sign(priv, msg)
+	}
+	cleanup = func() {
+		mu.Lock()
+		defer mu.Unlock()
+		clear(priv)
+		priv = nil
+	}
+	return sign, cleanup
+}
+
+// Signer returns the node's ed25519 private key as a crypto.Signer, e.g. for
+// tls.Certificate or x509.CreateCertificate. Its Public method returns an ed25519.PublicKey.
+// As with any ed25519 crypto.Signer, Sign expects the unhashed message and crypto.Hash(0) as options.
+// It returns nil for nodes without a 32-byte private key and for wiped nodes.
+func (k *node) Signer() crypto.Signer {
+	_, priv, err := k.signingKeypair()
+	if err != nil {
+		return nil
+	}
+	return priv
+}
+
+// SignSplit signs the message with the node's ed25519 private key and returns the two halves
+// of the 64-byte signature separately, for verifiers that take them as distinct fields:
+// r is the encoded point R and s the scalar S, so r || s is the signature ed25519.Sign returns.
+// It returns ErrInvalidPrivateKey for nodes without a 32-byte private key
+// and for wiped nodes, whose key is all zeros.
+func (k *node) SignSplit(message []byte) (r, s [32]byte, err error) {
+	_, priv, err := k.signingKeypair()
+	if err != nil {
+		return r, s, err
+	}
+	defer clear(priv)
+	sig := ed25519.Sign(priv, message)
+	copy(r[:], sig[:32])
+	copy(s[:], sig[32:])
+	return r, s, nil
+}
+
+// SignBatch signs every message with the node's ed25519 private key and returns
+// the signatures in the order of the messages. The key pair is computed once for the whole batch,
+// which saves a key expansion and a scalar multiplication per message over calling Keypair for each.
+// It returns ErrInvalidPrivateKey before signing anything for nodes without a 32-byte private key
+// and for wiped nodes, whose key is all zeros.
+func (k *node) SignBatch(messages [][]byte) ([][]byte, error) {
+	_, priv, err := k.signingKeypair()
+	if err != nil {
+		return nil, err
+	}
+	defer clear(priv)
+	sigs := make([][]byte, len(messages))
+	for i, msg := range messages {
+		sigs[i] = ed25519.Sign(priv, msg)
+	}
+	return sigs, nil
+}
+
+// signingKeypair is keypair for the methods that sign or hand out a signing key:
+// it also returns ErrInvalidPrivateKey for wiped nodes, so that they never sign with the all-zero key.
+func (k *node) signingKeypair() (ed25519.PublicKey, ed25519.PrivateKey, error) {
+	pub, priv, err := k.keypair()
+	if err != nil {
+		return nil, nil, err
+	}
+	if isZero(k.key) {
+		clear(priv)
+		return nil, nil, ErrInvalidPrivateKey
+	}
+	return pub, priv, nil
+}
+
+func isZero(b []byte) bool {
+	var acc byte
+	for _, v := range b {
+		acc |= v
+	}
+	return acc == 0
+}
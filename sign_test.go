@@ -0,0 +1,210 @@
+package slip10
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestNode_SigningFunc(t *testing.T) {
+	node, err := DeriveForPath("m/0'/1'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	pub, _ := node.Keypair()
+	seed := append([]byte(nil), node.RawSeed()...)
+	msg := []byte("message")
+
+	sign, cleanup := node.SigningFunc()
+	sig := sign(msg)
+	if !ed25519.Verify(pub, msg, sig) {
+		t.Fatalf("sign() = %x, not a valid signature", sig)
+	}
+
+	cleanup()
+	if got := sign(msg); got != nil {
+		t.Errorf("sign() after cleanup = %x, want nil", got)
+	}
+	// cleanup is idempotent and leaves the node usable
+	cleanup()
+	if !bytes.Equal(node.RawSeed(), seed) {
+		t.Errorf("RawSeed() after cleanup = %x, want %x", node.RawSeed(), seed)
+	}
+}
+
+func TestNode_Signer(t *testing.T) {
+	node, err := DeriveForPath("m/0'/1'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	signer := node.Signer()
+	pub, _ := node.Keypair()
+	if got, ok := signer.Public().(ed25519.PublicKey); !ok || !got.Equal(pub) {
+		t.Fatalf("Signer().Public() = %v, want %x", signer.Public(), pub)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "slip10"},
+		NotBefore:             time.Unix(0, 0),
+		NotAfter:              time.Unix(0, 0).Add(24 * time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, signer.Public(), signer)
+	if err != nil {
+		t.Fatalf("CreateCertificate() error = %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("ParseCertificate() error = %v", err)
+	}
+	if err := cert.CheckSignatureFrom(cert); err != nil {
+		t.Errorf("CheckSignatureFrom() error = %v", err)
+	}
+	if got, ok := cert.PublicKey.(ed25519.PublicKey); !ok || !got.Equal(pub) {
+		t.Errorf("certificate public key = %v, want %x", cert.PublicKey, pub)
+	}
+}
+
+func TestNode_SignSplit(t *testing.T) {
+	k, err := DeriveForPath("m/0'/1'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	pub, priv := k.Keypair()
+	msg := []byte("message")
+
+	r, s, err := k.SignSplit(msg)
+	if err != nil {
+		t.Fatalf("SignSplit() error = %v", err)
+	}
+	sig := append(r[:], s[:]...)
+	if want := ed25519.Sign(priv, msg); !bytes.Equal(sig, want) {
+		t.Errorf("SignSplit() = %x, want %x", sig, want)
+	}
+	if !ed25519.Verify(pub, msg, sig) {
+		t.Errorf("SignSplit() = %x, not a valid signature", sig)
+	}
+
+	if _, _, err := (&node{}).SignSplit(msg); err != ErrInvalidPrivateKey {
+		t.Errorf("SignSplit() error = %v, wantErr %v", err, ErrInvalidPrivateKey)
+	}
+
+	// a wiped node must not sign with the all-zero key
+	k.(*node).wipe()
+	r, s, err = k.SignSplit(msg)
+	if err != ErrInvalidPrivateKey {
+		t.Errorf("SignSplit() after wipe error = %v, wantErr %v", err, ErrInvalidPrivateKey)
+	}
+	if r != ([32]byte{}) || s != ([32]byte{}) {
+		t.Errorf("SignSplit() after wipe = %x, %x, want zeros", r, s)
+	}
+}
+
+func TestNode_SignBatch(t *testing.T) {
+	k, err := DeriveForPath("m/0'/1'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	pub, priv := k.Keypair()
+	messages := [][]byte{[]byte("first"), nil, []byte("third")}
+
+	sigs, err := k.SignBatch(messages)
+	if err != nil {
+		t.Fatalf("SignBatch() error = %v", err)
+	}
+	if len(sigs) != len(messages) {
+		t.Fatalf("SignBatch() returned %d signatures, want %d", len(sigs), len(messages))
+	}
+	for i, msg := range messages {
+		if want := ed25519.Sign(priv, msg); !bytes.Equal(sigs[i], want) {
+			t.Errorf("SignBatch()[%d] = %x, want %x", i, sigs[i], want)
+		}
+		if !ed25519.Verify(pub, msg, sigs[i]) {
+			t.Errorf("SignBatch()[%d] = %x, not a valid signature", i, sigs[i])
+		}
+	}
+
+	tests := []struct {
+		name string
+		node *node
+	}{
+		{name: "no private key", node: &node{}},
+		{name: "wiped", node: &node{key: make([]byte, Ed25519KeyLen), chainCode: make([]byte, ChainCodeLen)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.node.SignBatch(messages); err != ErrInvalidPrivateKey {
+				t.Errorf("SignBatch() error = %v, wantErr %v", err, ErrInvalidPrivateKey)
+			}
+		})
+	}
+}
+
+func BenchmarkNode_SignBatch(b *testing.B) {
+	k, err := DeriveForPath("m/0'/1'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		b.Fatalf("DeriveForPath() error = %v", err)
+	}
+	messages := make([][]byte, 100)
+	for i := range messages {
+		messages[i] = []byte{byte(i)}
+	}
+
+	b.Run("SignBatch", func(b *testing.B) {
+		for b.Loop() {
+			if _, err := k.SignBatch(messages); err != nil {
+				b.Fatalf("SignBatch() error = %v", err)
+			}
+		}
+	})
+	b.Run("Keypair and Sign", func(b *testing.B) {
+		for b.Loop() {
+			for _, msg := range messages {
+				_, priv := k.Keypair()
+				ed25519.Sign(priv, msg)
+			}
+		}
+	})
+}
+
+func TestNode_WipedKeyDoesNotSign(t *testing.T) {
+	key, err := DeriveForPath("m/0'/1'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	k := key.(*node)
+	k.wipe()
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{name: "SignSplit", call: func() error { _, _, err := k.SignSplit(nil); return err }},
+		{name: "SignBatch", call: func() error { _, err := k.SignBatch([][]byte{nil}); return err }},
+		{name: "SignJWT", call: func() error { _, err := k.SignJWT(map[string]any{}); return err }},
+		{name: "SignStatement", call: func() error { _, _, err := k.SignStatement("", "example.com", "abcdefgh"); return err }},
+		{name: "GitSigningKey", call: func() error { _, _, err := k.GitSigningKey("alice@example.com"); return err }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err != ErrInvalidPrivateKey {
+				t.Errorf("%s() error = %v, wantErr %v", tt.name, err, ErrInvalidPrivateKey)
+			}
+		})
+	}
+
+	if signer := k.Signer(); signer != nil {
+		t.Errorf("Signer() = %v, want nil", signer)
+	}
+	if sign, _ := k.SigningFunc(); sign([]byte("msg")) != nil {
+		t.Errorf("SigningFunc() sign returned a signature, want nil")
+	}
+}
@@ -0,0 +1,66 @@
+package slip10
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"strings"
+)
+
+// signInNonceMinLen is the minimum nonce length of EIP-4361.
+const signInNonceMinLen = 8
+
+var ErrInvalidSignInMessage = fmt.Errorf("invalid sign-in message")
+
+// SignStatement builds a sign-in message in the EIP-4361 format as adapted to Solana
+// (Sign In With Solana) and signs it with the node's ed25519 key:
+//
+//	${domain} wants you to sign in with your Solana account:
+//	${base58 public key}
+//
+//	${statement}
+//
+//	Version: 1
+//	Nonce: ${nonce}
+//
+// The statement and its blank line are omitted if the statement is empty.
+// It returns the signature and the exact signed message, which the verifier checks
+// with ed25519.Verify against the public key in the message.
+// The domain must not be empty, no field may contain a line break,
+// and the nonce must be at least 8 alphanumeric characters, as in EIP-4361.
+// It returns ErrInvalidPrivateKey for nodes without a 32-byte private key and for wiped nodes.
+func (k *node) SignStatement(statement string, domain string, nonce string) (signature []byte, signedMessage string, err error) {
+	pub, priv, err := k.signingKeypair()
+	if err != nil {
+		return nil, "", err
+	}
+	defer clear(priv)
+	if domain == "" || strings.ContainsAny(domain, "\r\n") || strings.ContainsAny(statement, "\r\n") {
+		return nil, "", ErrInvalidSignInMessage
+	}
+	if len(nonce) < signInNonceMinLen || !isAlphanumeric(nonce) {
+		return nil, "", ErrInvalidSignInMessage
+	}
+
+	var b strings.Builder
+	b.WriteString(domain + " wants you to sign in with your Solana account:\n")
+	b.WriteString(base58Encode(pub) + "\n\n")
+	if statement != "" {
+		b.WriteString(statement + "\n\n")
+	}
+	b.WriteString("Version: 1\n")
+	b.WriteString("Nonce: " + nonce)
+	signedMessage = b.String()
+
+	return ed25519.Sign(priv, []byte(signedMessage)), signedMessage, nil
+}
+
+func isAlphanumeric(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,70 @@
+package slip10
+
+import (
+	"crypto/ed25519"
+	"testing"
+)
+
+func TestNode_SignStatement(t *testing.T) {
+	node, err := DeriveForPath("m/44'/501'/0'/0'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	pub, _ := node.Keypair()
+
+	type args struct {
+		statement string
+		domain    string
+		nonce     string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "with statement",
+			args: args{statement: "Sign in to the example app.", domain: "example.com", nonce: "32891756"},
+			want: "example.com wants you to sign in with your Solana account:\n" +
+				"39LoiUgZejnJYJVhvvAnxkMooM1uJ15Hkiz2iXTUwF65\n" +
+				"\n" +
+				"Sign in to the example app.\n" +
+				"\n" +
+				"Version: 1\n" +
+				"Nonce: 32891756",
+		},
+		{
+			name: "without statement",
+			args: args{domain: "example.com", nonce: "abcDEF123"},
+			want: "example.com wants you to sign in with your Solana account:\n" +
+				"39LoiUgZejnJYJVhvvAnxkMooM1uJ15Hkiz2iXTUwF65\n" +
+				"\n" +
+				"Version: 1\n" +
+				"Nonce: abcDEF123",
+		},
+		{name: "empty domain", args: args{nonce: "32891756"}, wantErr: ErrInvalidSignInMessage},
+		{name: "line break in statement", args: args{statement: "a\nNonce: 1", domain: "example.com", nonce: "32891756"}, wantErr: ErrInvalidSignInMessage},
+		{name: "line break in domain", args: args{domain: "example.com\nevil.com", nonce: "32891756"}, wantErr: ErrInvalidSignInMessage},
+		{name: "short nonce", args: args{domain: "example.com", nonce: "1234567"}, wantErr: ErrInvalidSignInMessage},
+		{name: "non-alphanumeric nonce", args: args{domain: "example.com", nonce: "1234-5678"}, wantErr: ErrInvalidSignInMessage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sig, msg, err := node.SignStatement(tt.args.statement, tt.args.domain, tt.args.nonce)
+			if err != tt.wantErr {
+				t.Fatalf("SignStatement() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if msg != tt.want {
+				t.Errorf("SignStatement() message = %q, want %q", msg, tt.want)
+			}
+			if !ed25519.Verify(pub, []byte(msg), sig) {
+				t.Errorf("SignStatement() signature does not verify")
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package slip10
+
+import "strconv"
+
+// SolanaKeypairJSON returns the 64-byte ed25519 private key as a JSON array of numbers,
+// the format of Solana CLI keypair files such as ~/.config/solana/id.json.
+// It returns ErrInvalidPrivateKey for nodes without a 32-byte private key.
+func (k *node) SolanaKeypairJSON() ([]byte, error) {
+	_, priv, err := k.keypair()
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 0, len(priv)*4+2)
+	buf = append(buf, '[')
+	for i, b := range priv {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = strconv.AppendUint(buf, uint64(b), 10)
+	}
+	buf = append(buf, ']')
+	return buf, nil
+}
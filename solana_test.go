@@ -0,0 +1,37 @@
+package slip10
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestNode_SolanaKeypairJSON(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	node, err := DeriveForPath("m/44'/501'/0'/0'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	got, err := node.SolanaKeypairJSON()
+	if err != nil {
+		t.Fatalf("SolanaKeypairJSON() error = %v", err)
+	}
+
+	var ints []int
+	if err := json.Unmarshal(got, &ints); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	loaded := make([]byte, len(ints))
+	for i, v := range ints {
+		if v < 0 || v > 255 {
+			t.Fatalf("SolanaKeypairJSON() has non-byte value %d", v)
+		}
+		loaded[i] = byte(v)
+	}
+
+	_, priv := node.Keypair()
+	if !bytes.Equal(loaded, priv) {
+		t.Errorf("SolanaKeypairJSON() = %X, want %X", loaded, []byte(priv))
+	}
+}
@@ -0,0 +1,68 @@
+package slip10
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"strings"
+)
+
+const (
+	sshKeyType      = "ssh-ed25519"
+	sshPrivateMagic = "openssh-key-v1\x00"
+	sshPrivatePEM   = "OPENSSH PRIVATE KEY"
+)
+
+var ErrInvalidPrincipal = fmt.Errorf("invalid principal")
+
+// GitSigningKey returns the node's ed25519 key for SSH commit signing in git (gpg.format = ssh):
+// the unencrypted private key in the OpenSSH format, PEM encoded, with the comment,
+// and the line of an allowed_signers file that trusts the key for the "git" namespace
+// with the comment as principal, e.g. `alice@example.com namespaces="git" ssh-ed25519 AAAA...`.
+// The output is deterministic, so the same node and comment always give the same bytes.
+// The comment must be a non-empty principal without whitespace, e.g. an email address.
+// It returns ErrInvalidPrivateKey for nodes without a 32-byte private key and for wiped nodes.
+func (k *node) GitSigningKey(comment string) (privatePEM []byte, allowedSigner string, err error) {
+	pub, priv, err := k.signingKeypair()
+	if err != nil {
+		return nil, "", err
+	}
+	if comment == "" || strings.ContainsAny(comment, " \t\r\n") {
+		return nil, "", ErrInvalidPrincipal
+	}
+
+	pubBlob := sshAppendString(sshAppendString(nil, []byte(sshKeyType)), pub)
+
+	// the check integers only detect a wrong passphrase, so derive them from the public key
+	// instead of using random ones to keep the output deterministic
+	check := sha256.Sum256(pubBlob)
+	private := append(check[:4:4], check[:4]...)
+	private = sshAppendString(private, []byte(sshKeyType))
+	private = sshAppendString(private, pub)
+	private = sshAppendString(private, priv)
+	private = sshAppendString(private, []byte(comment))
+	// pad to the 8-byte block size of the "none" cipher with 1, 2, 3...
+	for i := byte(1); len(private)%8 != 0; i++ {
+		private = append(private, i)
+	}
+
+	blob := []byte(sshPrivateMagic)
+	blob = sshAppendString(blob, []byte("none")) // cipher
+	blob = sshAppendString(blob, []byte("none")) // kdf
+	blob = sshAppendString(blob, nil)            // kdf options
+	blob = binary.BigEndian.AppendUint32(blob, 1)
+	blob = sshAppendString(blob, pubBlob)
+	blob = sshAppendString(blob, private)
+
+	privatePEM = pem.EncodeToMemory(&pem.Block{Type: sshPrivatePEM, Bytes: blob})
+	allowedSigner = comment + ` namespaces="git" ` + sshKeyType + " " + base64.StdEncoding.EncodeToString(pubBlob)
+	return privatePEM, allowedSigner, nil
+}
+
+// sshAppendString appends b as an SSH string: its 4-byte big-endian length followed by the bytes.
+func sshAppendString(buf, b []byte) []byte {
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(b)))
+	return append(buf, b...)
+}
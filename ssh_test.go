@@ -0,0 +1,80 @@
+package slip10
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func TestNode_GitSigningKey(t *testing.T) {
+	node, err := DeriveForPath("m/0'/1'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	privatePEM, allowedSigner, err := node.GitSigningKey("alice@example.com")
+	if err != nil {
+		t.Fatalf("GitSigningKey() error = %v", err)
+	}
+	wantSigner := `alice@example.com namespaces="git" ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBkypScPM1vtYX1bk1yArtsaNb2fweMayv1TcsMPXBGH`
+	if allowedSigner != wantSigner {
+		t.Errorf("GitSigningKey() allowedSigner = %v, want %v", allowedSigner, wantSigner)
+	}
+
+	raw, err := ssh.ParseRawPrivateKey(privatePEM)
+	if err != nil {
+		t.Fatalf("ParseRawPrivateKey() error = %v", err)
+	}
+	_, priv := node.Keypair()
+	if got := *raw.(*ed25519.PrivateKey); !bytes.Equal(got, priv) {
+		t.Errorf("GitSigningKey() private key = %x, want %x", got, priv)
+	}
+
+	// the key of the allowed signer verifies signatures of the private key
+	signerKey, _, options, _, err := ssh.ParseAuthorizedKey([]byte(strings.TrimPrefix(allowedSigner, "alice@example.com ")))
+	if err != nil {
+		t.Fatalf("ParseAuthorizedKey() error = %v", err)
+	}
+	if len(options) != 1 || options[0] != `namespaces="git"` {
+		t.Errorf("GitSigningKey() allowedSigner options = %v", options)
+	}
+	signer, err := ssh.ParsePrivateKey(privatePEM)
+	if err != nil {
+		t.Fatalf("ParsePrivateKey() error = %v", err)
+	}
+	if !bytes.Equal(signer.PublicKey().Marshal(), signerKey.Marshal()) {
+		t.Errorf("ParsePrivateKey() public key = %s, want %s",
+			ssh.MarshalAuthorizedKey(signer.PublicKey()), ssh.MarshalAuthorizedKey(signerKey))
+	}
+	sig, err := signer.Sign(nil, []byte("commit"))
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	if err := signerKey.Verify([]byte("commit"), sig); err != nil {
+		t.Errorf("Verify() error = %v", err)
+	}
+
+	again, _, err := node.GitSigningKey("alice@example.com")
+	if err != nil {
+		t.Fatalf("GitSigningKey() error = %v", err)
+	}
+	if !bytes.Equal(again, privatePEM) {
+		t.Errorf("GitSigningKey() is not deterministic")
+	}
+}
+
+func TestNode_GitSigningKey_InvalidPrincipal(t *testing.T) {
+	node, err := NewMasterNode(hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("NewMasterNode() error = %v", err)
+	}
+
+	for _, comment := range []string{"", "alice bob", "alice\n"} {
+		if _, _, err := node.GitSigningKey(comment); err != ErrInvalidPrincipal {
+			t.Errorf("GitSigningKey(%q) error = %v, wantErr %v", comment, err, ErrInvalidPrincipal)
+		}
+	}
+}
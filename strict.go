@@ -0,0 +1,166 @@
+package slip10
+
+import "fmt"
+
+const (
+	// MinSeedLength is the shortest seed accepted in strict mode (128 bits), as in BIP-32.
+	MinSeedLength = 16
+	// MaxSeedLength is the longest seed accepted in strict mode (512 bits), as in BIP-32.
+	MaxSeedLength = 64
+)
+
+var (
+	ErrInvalidSeedLength = fmt.Errorf("invalid seed length")
+	ErrWeakSeed          = fmt.Errorf("weak seed")
+)
+
+// StrictConfig holds the guardrails applied by the strict derivation functions.
+// A zero field disables the corresponding check, except MaxDepth:
+// a zero MaxDepth falls back to MaxPathDepth, which DeriveForPath always enforces.
+type StrictConfig struct {
+	// MinSeedLength and MaxSeedLength bound the seed length in bytes.
+	MinSeedLength int
+	MaxSeedLength int
+	// MaxDepth caps the number of segments in a derivation path; zero means MaxPathDepth.
+	MaxDepth int
+	// RejectWeakSeeds rejects seeds made of a single repeated byte, e.g. all zeroes.
+	RejectWeakSeeds bool
+	// RejectStructuredSeeds rejects seeds for which SeedLooksStructured is true,
+	// e.g. the sequential seeds of test vectors.
+	RejectStructuredSeeds bool
+}
+
+// DefaultStrictConfig is used by DeriveForPathStrict and NewMasterNodeStrict.
+// It accepts 16 to 64 byte seeds, rejects seeds made of a single repeated byte
+// and paths deeper than 255 segments. It accepts structured seeds, so that the
+// test vectors keep working; set RejectStructuredSeeds to reject them as well.
+var DefaultStrictConfig = StrictConfig{
+	MinSeedLength:   MinSeedLength,
+	MaxSeedLength:   MaxSeedLength,
+	MaxDepth:        MaxPathDepth,
+	RejectWeakSeeds: true,
+}
+
+// DeriveForPathStrict is DeriveForPath with the checks of DefaultStrictConfig.
+func DeriveForPathStrict(path string, seed []byte) (Node, error) {
+	return DefaultStrictConfig.DeriveForPath(path, seed)
+}
+
+// NewMasterNodeStrict is NewMasterNode with the checks of DefaultStrictConfig.
+func NewMasterNodeStrict(seed []byte) (Node, error) {
+	return DefaultStrictConfig.NewMasterNode(seed)
+}
+
+// DeriveForPath checks the path and the seed and then derives the key as DeriveForPath does.
+func (c StrictConfig) DeriveForPath(path string, seed []byte) (Node, error) {
+	if err := c.CheckPath(path); err != nil {
+		return nil, err
+	}
+	if err := c.CheckSeed(seed); err != nil {
+		return nil, err
+	}
+
+	return DeriveForPath(path, seed)
+}
+
+// NewMasterNode checks the seed and then generates the master key as NewMasterNode does.
+func (c StrictConfig) NewMasterNode(seed []byte) (Node, error) {
+	if err := c.CheckSeed(seed); err != nil {
+		return nil, err
+	}
+
+	return NewMasterNode(seed)
+}
+
+// CheckSeed returns ErrInvalidSeedLength if the seed length is out of bounds
+// and ErrWeakSeed if weak seeds are rejected and the seed is made of a single repeated byte,
+// or if structured seeds are rejected and SeedLooksStructured is true for it.
+func (c StrictConfig) CheckSeed(seed []byte) error {
+	if c.MinSeedLength > 0 && len(seed) < c.MinSeedLength {
+		return ErrInvalidSeedLength
+	}
+	if c.MaxSeedLength > 0 && len(seed) > c.MaxSeedLength {
+		return ErrInvalidSeedLength
+	}
+
+	if c.RejectWeakSeeds && isRepeatedByte(seed) {
+		return ErrWeakSeed
+	}
+	if c.RejectStructuredSeeds && SeedLooksStructured(seed) {
+		return ErrWeakSeed
+	}
+
+	return nil
+}
+
+// CheckPath returns ErrInvalidPath if the path is not valid
+// and ErrPathTooDeep if it has more than MaxDepth segments.
+func (c StrictConfig) CheckPath(path string) error {
+	maxDepth := c.MaxDepth
+	if maxDepth <= 0 {
+		maxDepth = MaxPathDepth
+	}
+	if pathDepth(path) > maxDepth {
+		return ErrPathTooDeep
+	}
+
+	if !IsValidPathWithDepth(path, 0) {
+		return ErrInvalidPath
+	}
+
+	return nil
+}
+
+func isRepeatedByte(seed []byte) bool {
+	for _, b := range seed {
+		if b != seed[0] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SeedLooksStructured reports whether the seed follows an obvious pattern,
+// which suggests a demo or test seed rather than random bytes:
+//   - every byte differs from the previous one by the same amount (mod 256),
+//     e.g. 000102...0f, fffefd... or a single repeated byte;
+//   - the seed is a short block repeated, possibly truncated, e.g. deadbeefdeadbeef...,
+//     where the block is at most half the seed long.
+//
+// Random 16-byte seeds match with negligible probability.
+func SeedLooksStructured(seed []byte) bool {
+	if len(seed) < 2 {
+		return true
+	}
+
+	sequential := true
+	step := seed[1] - seed[0]
+	for i := 2; i < len(seed); i++ {
+		if seed[i]-seed[i-1] != step {
+			sequential = false
+			break
+		}
+	}
+	if sequential {
+		return true
+	}
+
+	for period := 1; period <= len(seed)/2; period++ {
+		if isPeriodic(seed, period) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isPeriodic(seed []byte, period int) bool {
+	for i := period; i < len(seed); i++ {
+		if seed[i] != seed[i-period] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,167 @@
+package slip10
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestDeriveForPathStrict(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	type args struct {
+		path string
+		seed []byte
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantPriv []byte
+		wantErr  error
+	}{
+		{
+			name: "vector 1 seed",
+			args: args{
+				path: "m/0'/1'",
+				seed: seed,
+			},
+			wantPriv: hexMustDecode("b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2"),
+		},
+		{
+			name: "short seed",
+			args: args{
+				path: "m/0'",
+				seed: seed[:15],
+			},
+			wantErr: ErrInvalidSeedLength,
+		},
+		{
+			name: "long seed",
+			args: args{
+				path: "m/0'",
+				seed: make([]byte, 65),
+			},
+			wantErr: ErrInvalidSeedLength,
+		},
+		{
+			name: "all-zero seed",
+			args: args{
+				path: "m/0'",
+				seed: make([]byte, 32),
+			},
+			wantErr: ErrWeakSeed,
+		},
+		{
+			name: "repeated byte seed",
+			args: args{
+				path: "m/0'",
+				seed: bytes.Repeat([]byte{0xaa}, 32),
+			},
+			wantErr: ErrWeakSeed,
+		},
+		{
+			name: "invalid path",
+			args: args{
+				path: "m/0",
+				seed: seed,
+			},
+			wantErr: ErrInvalidPath,
+		},
+		{
+			name: "too deep path",
+			args: args{
+				path: "m" + strings.Repeat("/0'", MaxPathDepth+1),
+				seed: seed,
+			},
+			wantErr: ErrPathTooDeep,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeriveForPathStrict(tt.args.path, tt.args.seed)
+			if err != tt.wantErr {
+				t.Errorf("DeriveForPathStrict() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if err != nil {
+				return
+			}
+
+			priv := got.PrivateKey()
+			if !bytes.Equal(priv, tt.wantPriv) {
+				t.Errorf("PrivateKey() = %X, want %X", priv, tt.wantPriv)
+			}
+		})
+	}
+}
+
+func TestStrictConfig_ZeroValue(t *testing.T) {
+	// the zero config keeps the permissive behaviour of DeriveForPath
+	var c StrictConfig
+	if _, err := c.DeriveForPath("m/0'", make([]byte, 8)); err != nil {
+		t.Errorf("DeriveForPath() error = %v", err)
+	}
+	if _, err := c.NewMasterNode(make([]byte, 8)); err != nil {
+		t.Errorf("NewMasterNode() error = %v", err)
+	}
+}
+
+func TestStrictConfig_ZeroMaxDepth(t *testing.T) {
+	// a zero MaxDepth falls back to MaxPathDepth instead of disabling the check
+	c := StrictConfig{}
+	if err := c.CheckPath("m" + strings.Repeat("/0'", MaxPathDepth)); err != nil {
+		t.Errorf("CheckPath() at MaxPathDepth error = %v", err)
+	}
+	if err := c.CheckPath("m" + strings.Repeat("/0'", MaxPathDepth+1)); err != ErrPathTooDeep {
+		t.Errorf("CheckPath() error = %v, want %v", err, ErrPathTooDeep)
+	}
+	_, err := c.DeriveForPath("m"+strings.Repeat("/0'", MaxPathDepth+1), hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != ErrPathTooDeep {
+		t.Errorf("DeriveForPath() error = %v, want %v", err, ErrPathTooDeep)
+	}
+}
+
+func TestSeedLooksStructured(t *testing.T) {
+	tests := []struct {
+		name string
+		seed []byte
+		want bool
+	}{
+		{name: "vector 1 seed", seed: hexMustDecode("000102030405060708090a0b0c0d0e0f"), want: true},
+		{name: "vector 2 seed", seed: hexMustDecode("fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542"), want: true},
+		{name: "descending", seed: hexMustDecode("0f0e0d0c0b0a09080706050403020100"), want: true},
+		{name: "wrapping around", seed: hexMustDecode("f8f9fafbfcfdfeff0001020304050607"), want: true},
+		{name: "all zeroes", seed: make([]byte, 32), want: true},
+		{name: "repeated block", seed: hexMustDecode("deadbeefdeadbeefdeadbeefdeadbeef"), want: true},
+		{name: "truncated repeated block", seed: hexMustDecode("0102030a0102030a0102030a010203"), want: true},
+		{name: "empty", seed: nil, want: true},
+		{name: "random", seed: hexMustDecode("4b381541583be4423346c643850da4b3"), want: false},
+		{name: "random 64 bytes", seed: hexMustDecode("c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SeedLooksStructured(tt.seed); got != tt.want {
+				t.Errorf("SeedLooksStructured() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrictConfig_RejectStructuredSeeds(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	// the default config keeps accepting the test vectors
+	if _, err := DeriveForPathStrict("m/0'", seed); err != nil {
+		t.Errorf("DeriveForPathStrict() error = %v", err)
+	}
+
+	c := DefaultStrictConfig
+	c.RejectStructuredSeeds = true
+	if _, err := c.DeriveForPath("m/0'", seed); err != ErrWeakSeed {
+		t.Errorf("DeriveForPath() error = %v, wantErr %v", err, ErrWeakSeed)
+	}
+	if _, err := c.DeriveForPath("m/0'", hexMustDecode("4b381541583be4423346c643850da4b3")); err != nil {
+		t.Errorf("DeriveForPath() error = %v", err)
+	}
+}
@@ -0,0 +1,38 @@
+package slip10
+
+import "fmt"
+
+var ErrInvalidChainCode = fmt.Errorf("invalid chain code")
+
+// Subtree returns copies of the node's key and chain code, which are all that is needed
+// to derive its descendants, so a subtree such as an account can be delegated with
+// NewNodeFromSubtree without sharing the seed.
+// The holder of a subtree can derive every descendant of the node and all their private keys,
+// but neither the node's ancestors nor its siblings.
+func (k *node) Subtree() (key, chainCode []byte) {
+	if k == nil {
+		return nil, nil
+	}
+	return append([]byte(nil), k.key...), append([]byte(nil), k.chainCode...)
+}
+
+// NewNodeFromSubtree reconstructs a node from the key and chain code returned by Subtree
+// and its depth. The node derives the same descendants as the original one;
+// its origin is unknown, as for nodes restored with ResumeFromCheckpoint.
+func NewNodeFromSubtree(key, chainCode []byte, depth uint8, curve Curve) (Node, error) {
+	if curve != CurveEd25519 {
+		return nil, ErrUnknownCurve
+	}
+	if len(key) != Ed25519KeyLen {
+		return nil, ErrInvalidPrivateKey
+	}
+	if len(chainCode) != ChainCodeLen {
+		return nil, ErrInvalidChainCode
+	}
+
+	return &node{
+		key:       append([]byte(nil), key...),
+		chainCode: append([]byte(nil), chainCode...),
+		depth:     depth,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package slip10
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNewNodeFromSubtree(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	account, err := DeriveForPath("m/44'/501'/0'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	key, chainCode := account.Subtree()
+
+	delegated, err := NewNodeFromSubtree(key, chainCode, 3, CurveEd25519)
+	if err != nil {
+		t.Fatalf("NewNodeFromSubtree() error = %v", err)
+	}
+	child, err := delegated.Derive(FirstHardenedIndex + 7)
+	if err != nil {
+		t.Fatalf("Derive() error = %v", err)
+	}
+	want, err := DeriveForPath("m/44'/501'/0'/7'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	if !bytes.Equal(child.RawSeed(), want.RawSeed()) {
+		t.Errorf("Derive() = %x, want %x", child.RawSeed(), want.RawSeed())
+	}
+	if _, err := delegated.Path(); err != ErrUnknownOrigin {
+		t.Errorf("Path() error = %v, wantErr %v", err, ErrUnknownOrigin)
+	}
+
+	// Subtree returns copies
+	key[0] ^= 0xff
+	if bytes.Equal(account.RawSeed(), key) {
+		t.Errorf("Subtree() key shares memory with the node")
+	}
+}
+
+func TestNewNodeFromSubtree_Invalid(t *testing.T) {
+	key := make([]byte, Ed25519KeyLen)
+	chainCode := make([]byte, ChainCodeLen)
+
+	tests := []struct {
+		name      string
+		key       []byte
+		chainCode []byte
+		curve     Curve
+		wantErr   error
+	}{
+		{name: "short key", key: key[:31], chainCode: chainCode, curve: CurveEd25519, wantErr: ErrInvalidPrivateKey},
+		{name: "short chain code", key: key, chainCode: chainCode[:31], curve: CurveEd25519, wantErr: ErrInvalidChainCode},
+		{name: "unknown curve", key: key, chainCode: chainCode, curve: Curve(42), wantErr: ErrUnknownCurve},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewNodeFromSubtree(tt.key, tt.chainCode, 0, tt.curve); err != tt.wantErr {
+				t.Errorf("NewNodeFromSubtree() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
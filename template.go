@@ -0,0 +1,144 @@
+package slip10
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidTemplate = fmt.Errorf("invalid path template")
+	ErrInvalidIndex    = fmt.Errorf("invalid index")
+
+	templateRegex = regexp.MustCompile(`^m(/([0-9]+|\*)')*$`)
+)
+
+// templateSegment is a segment of a path template: either a fixed index or the wildcard "*".
+type templateSegment struct {
+	index    uint32
+	wildcard bool
+}
+
+// parseTemplate parses a path template such as "m/44'/501'/*'/0'",
+// where "*" stands for any index. Indices are returned without the hardened offset.
+func parseTemplate(template string) ([]templateSegment, error) {
+	if pathDepth(template) > MaxPathDepth || !templateRegex.MatchString(template) {
+		return nil, ErrInvalidTemplate
+	}
+
+	segments := strings.Split(template, "/")
+	parsed := make([]templateSegment, 0, len(segments)-1)
+	for _, segment := range segments[1:] {
+		segment = strings.TrimRight(segment, "'")
+		if segment == "*" {
+			parsed = append(parsed, templateSegment{wildcard: true})
+			continue
+		}
+
+		i64, err := strconv.ParseUint(segment, 10, 32)
+		if err != nil || uint32(i64) >= FirstHardenedIndex {
+			return nil, ErrInvalidTemplate
+		}
+		parsed = append(parsed, templateSegment{index: uint32(i64)})
+	}
+
+	return parsed, nil
+}
+
+// FindPath searches for the path that derives the target public key from the seed.
+// Every template is searched in order, with each "*" segment of the template
+// taking the indices from 0 to maxIndex inclusive, e.g. "m/44'/501'/*'/0'".
+// The target is either a bare 32-byte public key or one prefixed with 0x00.
+// It returns the matching path and true, or false if no path in the given bounds matches.
+func FindPath(seed []byte, target []byte, templates []string, maxIndex uint32) (string, bool, error) {
+	if len(target) != Ed25519KeyLen {
+		var err error
+		if target, err = StripPublicPrefix(target); err != nil {
+			return "", false, err
+		}
+	}
+	if maxIndex >= FirstHardenedIndex {
+		return "", false, ErrInvalidIndex
+	}
+
+	parsed := make([][]templateSegment, len(templates))
+	for i, template := range templates {
+		segments, err := parseTemplate(template)
+		if err != nil {
+			return "", false, err
+		}
+		parsed[i] = segments
+	}
+
+	master, err := NewMasterNode(seed)
+	if err != nil {
+		return "", false, err
+	}
+
+	for _, segments := range parsed {
+		path, found, err := findPath(master, "m", segments, maxIndex, target)
+		if err != nil || found {
+			return path, found, err
+		}
+	}
+
+	return "", false, nil
+}
+
+func findPath(key Node, path string, segments []templateSegment, maxIndex uint32, target []byte) (string, bool, error) {
+	if len(segments) == 0 {
+		pub, _ := key.Keypair()
+		return path, subtle.ConstantTimeCompare(pub, target) == 1, nil
+	}
+
+	first, last := segments[0].index, segments[0].index
+	if segments[0].wildcard {
+		first, last = 0, maxIndex
+	}
+
+	for i := first; i <= last; i++ {
+		child, err := key.Derive(i + FirstHardenedIndex)
+		if err != nil {
+			return "", false, err
+		}
+
+		childPath := path + "/" + strconv.FormatUint(uint64(i), 10) + "'"
+		found, ok, err := findPath(child, childPath, segments[1:], maxIndex, target)
+		if err != nil || ok {
+			return found, ok, err
+		}
+	}
+
+	return "", false, nil
+}
+
+// MatchesTemplate reports whether the path has the shape of the template,
+// e.g. "m/44'/501'/3'/0'/7'" matches "m/44'/501'/*'/0'/*'".
+// Both must have the same number of segments; a "*" segment matches any index
+// and a fixed segment matches only the same index.
+// The path may mark hardened segments with "'", "h" or "H". It returns ErrInvalidPath
+// for a malformed path, ErrPathTooDeep for one deeper than MaxPathDepth and
+// ErrInvalidTemplate for a malformed template.
+func MatchesTemplate(path, template string) (bool, error) {
+	indices, err := parsePath(path)
+	if err != nil {
+		return false, err
+	}
+	segments, err := parseTemplate(template)
+	if err != nil {
+		return false, err
+	}
+
+	if len(indices) != len(segments) {
+		return false, nil
+	}
+	for n, segment := range segments {
+		if !segment.wildcard && indices[n] != segment.index+FirstHardenedIndex {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,138 @@
+package slip10
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFindPath(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	type args struct {
+		target    []byte
+		templates []string
+		maxIndex  uint32
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantPath  string
+		wantFound bool
+		wantErr   error
+	}{
+		{
+			name: "prefixed public key",
+			args: args{
+				target:    hexMustDecode("001932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187"),
+				templates: []string{"m/*'", "m/0'/*'"},
+				maxIndex:  5,
+			},
+			wantPath:  "m/0'/1'",
+			wantFound: true,
+		},
+		{
+			name: "bare public key",
+			args: args{
+				target:    hexMustDecode("ae98736566d30ed0e9d2f4486a64bc95740d89c7db33f52121f8ea8f76ff0fc1"),
+				templates: []string{"m/*'/*'/*'"},
+				maxIndex:  2,
+			},
+			wantPath:  "m/0'/1'/2'",
+			wantFound: true,
+		},
+		{
+			name: "master node",
+			args: args{
+				target:    hexMustDecode("a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed"),
+				templates: []string{"m"},
+			},
+			wantPath:  "m",
+			wantFound: true,
+		},
+		{
+			name: "out of bounds",
+			args: args{
+				target:    hexMustDecode("ae98736566d30ed0e9d2f4486a64bc95740d89c7db33f52121f8ea8f76ff0fc1"),
+				templates: []string{"m/*'/*'/*'"},
+				maxIndex:  1,
+			},
+			wantFound: false,
+		},
+		{
+			name: "invalid template",
+			args: args{
+				target:    hexMustDecode("ae98736566d30ed0e9d2f4486a64bc95740d89c7db33f52121f8ea8f76ff0fc1"),
+				templates: []string{"m/*"},
+			},
+			wantErr: ErrInvalidTemplate,
+		},
+		{
+			name: "invalid public key",
+			args: args{
+				target:    hexMustDecode("01ae98736566d30ed0e9d2f4486a64bc95740d89c7db33f52121f8ea8f76ff0fc1"),
+				templates: []string{"m/*'"},
+			},
+			wantErr: ErrInvalidPublicKey,
+		},
+		{
+			name: "invalid max index",
+			args: args{
+				target:    hexMustDecode("ae98736566d30ed0e9d2f4486a64bc95740d89c7db33f52121f8ea8f76ff0fc1"),
+				templates: []string{"m/*'"},
+				maxIndex:  FirstHardenedIndex,
+			},
+			wantErr: ErrInvalidIndex,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, found, err := FindPath(seed, tt.args.target, tt.args.templates, tt.args.maxIndex)
+			if err != tt.wantErr {
+				t.Errorf("FindPath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if found != tt.wantFound {
+				t.Errorf("FindPath() found = %v, want %v", found, tt.wantFound)
+			}
+			if path != tt.wantPath {
+				t.Errorf("FindPath() path = %v, want %v", path, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestMatchesTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		template string
+		want     bool
+		wantErr  error
+	}{
+		{name: "wildcards", path: "m/44'/501'/3'/0'/7'", template: "m/44'/501'/*'/0'/*'", want: true},
+		{name: "no wildcards", path: "m/44'/501'", template: "m/44'/501'", want: true},
+		{name: "master", path: "m", template: "m", want: true},
+		{name: "fixed segment mismatch", path: "m/44'/60'/3'/0'/7'", template: "m/44'/501'/*'/0'/*'", want: false},
+		{name: "fixed segment after wildcard mismatch", path: "m/44'/501'/3'/1'/7'", template: "m/44'/501'/*'/0'/*'", want: false},
+		{name: "shorter path", path: "m/44'/501'/3'", template: "m/44'/501'/*'/0'", want: false},
+		{name: "longer path", path: "m/44'/501'/3'/0'/1'", template: "m/44'/501'/*'/0'", want: false},
+		{name: "unhardened path segment", path: "m/44'/501'/3", template: "m/44'/501'/*'", wantErr: ErrInvalidPath},
+		{name: "invalid path", path: "44'/501'", template: "m/44'/501'", wantErr: ErrInvalidPath},
+		{name: "h notation", path: "m/44h/501H/3'", template: "m/44'/501'/*'", want: true},
+		{name: "too deep path", path: "m" + strings.Repeat("/0'", MaxPathDepth+1), template: "m/0'", wantErr: ErrPathTooDeep},
+		{name: "unhardened template segment", path: "m/44'/501'", template: "m/44'/*", wantErr: ErrInvalidTemplate},
+		{name: "invalid template", path: "m/44'/501'", template: "m/44'/x'", wantErr: ErrInvalidTemplate},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatchesTemplate(tt.path, tt.template)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MatchesTemplate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("MatchesTemplate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
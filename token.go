@@ -0,0 +1,101 @@
+package slip10
+
+import (
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// tokenSalt and recoveryCodeSalt separate tokens and recovery codes
+// from each other and from other keys derived with HKDF, e.g. the box key.
+const (
+	tokenSalt        = "slip10 token"
+	recoveryCodeSalt = "slip10 recovery code"
+)
+
+// maxTokenLen is the longest output of HKDF-SHA256.
+const maxTokenLen = 255 * sha256.Size
+
+// NonceLen is the length of the nonces returned by DeriveNonce.
+const NonceLen = 32
+
+var ErrInvalidTokenLength = fmt.Errorf("invalid token length")
+
+// DeriveToken derives n bytes with HKDF-SHA256 from the node's private key, using the label as info,
+// and returns them as an unpadded base64url string.
+// The token is deterministic: the same seed, path and label always give the same token,
+// so it can be regenerated after a loss, and rotating it requires a new label.
+// Tokens are as sensitive as passwords.
+func (k *node) DeriveToken(label string, n int) (string, error) {
+	if k == nil {
+		return "", ErrNilNode
+	}
+	if n <= 0 || n > maxTokenLen {
+		return "", ErrInvalidTokenLength
+	}
+
+	token, err := hkdf.Key(sha256.New, k.key, []byte(tokenSalt), label, n)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// RecoveryCode derives a human-readable recovery code from the node's private key with HKDF-SHA256:
+// groups of groupLen uppercase base32 characters separated by dashes, e.g. "ABCDE-FGHIJ-KLMNO".
+// The code is deterministic, so the same node always gives the same code.
+// Anyone with the seed can regenerate it, so it is a convenience, not a secret independent of the seed.
+func (k *node) RecoveryCode(groups, groupLen int) (string, error) {
+	if k == nil {
+		return "", ErrNilNode
+	}
+	if groups <= 0 || groupLen <= 0 || groups > maxTokenLen || groupLen > maxTokenLen {
+		return "", ErrInvalidTokenLength
+	}
+	chars := groups * groupLen
+	// every base32 character encodes 5 bits
+	n := (chars*5 + 7) / 8
+	if n > maxTokenLen {
+		return "", ErrInvalidTokenLength
+	}
+
+	raw, err := hkdf.Key(sha256.New, k.key, []byte(recoveryCodeSalt), "", n)
+	if err != nil {
+		return "", err
+	}
+	encoded := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(raw)
+
+	parts := make([]string, groups)
+	for i := range parts {
+		parts[i] = encoded[i*groupLen : (i+1)*groupLen]
+	}
+	return strings.Join(parts, "-"), nil
+}
+
+// DeriveNonce returns a NonceLen-byte nonce for replay protection of signed requests:
+// the first bytes of HMAC-SHA512 keyed by the node's private key over context
+// followed by the counter as 8 big-endian bytes.
+// The same node, context and counter always give the same nonce, which makes retries idempotent,
+// and different counters give independent nonces.
+// It is not an ed25519 signature nonce, which is derived inside signing and must never be exposed.
+// It returns ErrInvalidPrivateKey for nodes without a 32-byte private key.
+func (k *node) DeriveNonce(context []byte, counter uint64) ([]byte, error) {
+	if k == nil {
+		return nil, ErrNilNode
+	}
+	if len(k.key) != Ed25519KeyLen {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	mac := hmac.New(sha512.New, k.key)
+	mac.Write(context)
+	mac.Write(binary.BigEndian.AppendUint64(nil, counter))
+	return mac.Sum(nil)[:NonceLen], nil
+}
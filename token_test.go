@@ -0,0 +1,165 @@
+package slip10
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"testing"
+)
+
+func TestNode_DeriveToken(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	node, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		label   string
+		n       int
+		wantErr error
+	}{
+		{name: "32 bytes", label: "github", n: 32},
+		{name: "1 byte", label: "github", n: 1},
+		{name: "max length", label: "github", n: maxTokenLen},
+		{name: "zero length", label: "github", n: 0, wantErr: ErrInvalidTokenLength},
+		{name: "negative length", label: "github", n: -1, wantErr: ErrInvalidTokenLength},
+		{name: "too long", label: "github", n: maxTokenLen + 1, wantErr: ErrInvalidTokenLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := node.DeriveToken(tt.label, tt.n)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DeriveToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			raw, err := base64.RawURLEncoding.DecodeString(token)
+			if err != nil {
+				t.Fatalf("DeriveToken() = %q is not base64url: %v", token, err)
+			}
+			if len(raw) != tt.n {
+				t.Errorf("DeriveToken() decodes to %d bytes, want %d", len(raw), tt.n)
+			}
+		})
+	}
+}
+
+func TestNode_DeriveToken_Deterministic(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	node, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	again, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	other, err := DeriveForPath("m/0'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	token, _ := node.DeriveToken("github", 32)
+	if got, _ := again.DeriveToken("github", 32); got != token {
+		t.Errorf("DeriveToken() = %q on the same path, want %q", got, token)
+	}
+	if got, _ := node.DeriveToken("gitlab", 32); got == token {
+		t.Errorf("DeriveToken() with another label = %q, want a different token", got)
+	}
+	if got, _ := other.DeriveToken("github", 32); got == token {
+		t.Errorf("DeriveToken() on another path = %q, want a different token", got)
+	}
+}
+
+func TestNode_RecoveryCode(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	type args struct {
+		groups   int
+		groupLen int
+	}
+	tests := []struct {
+		name    string
+		path    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{name: "master", path: "m", args: args{groups: 5, groupLen: 5}, want: "6EX5S-KCERV-4FB6W-QX6CX-LUSWU"},
+		{name: "derived", path: "m/0'/1'", args: args{groups: 5, groupLen: 5}, want: "OTFXP-NRBUX-4SZAI-EPWB3-GPE7G"},
+		{name: "shorter code is a prefix", path: "m/0'/1'", args: args{groups: 2, groupLen: 5}, want: "OTFXP-NRBUX"},
+		{name: "single character", path: "m/0'/1'", args: args{groups: 1, groupLen: 1}, want: "O"},
+		{name: "no groups", path: "m", args: args{groups: 0, groupLen: 5}, wantErr: ErrInvalidTokenLength},
+		{name: "empty groups", path: "m", args: args{groups: 5, groupLen: 0}, wantErr: ErrInvalidTokenLength},
+		{name: "too long", path: "m", args: args{groups: maxTokenLen, groupLen: 2}, wantErr: ErrInvalidTokenLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := DeriveForPath(tt.path, seed)
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+
+			got, err := node.RecoveryCode(tt.args.groups, tt.args.groupLen)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RecoveryCode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("RecoveryCode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNode_DeriveNonce(t *testing.T) {
+	k, err := DeriveForPath("m/0'/1'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	got, err := k.DeriveNonce([]byte("api"), 1)
+	if err != nil {
+		t.Fatalf("DeriveNonce() error = %v", err)
+	}
+	// HMAC-SHA512(b1d0bad4...84f2, "api" || 0000000000000001)[:32]
+	want := hexMustDecode("1bc1f80bd593b690a1b2a036c667f239dd4a46a16d293253dd7fe9693bedf3d5")
+	if !bytes.Equal(got, want) {
+		t.Errorf("DeriveNonce() = %x, want %x", got, want)
+	}
+
+	again, err := k.DeriveNonce([]byte("api"), 1)
+	if err != nil {
+		t.Fatalf("DeriveNonce() error = %v", err)
+	}
+	if !bytes.Equal(again, got) {
+		t.Errorf("DeriveNonce() is not deterministic: %x, then %x", got, again)
+	}
+
+	seen := map[string]bool{string(got): true}
+	for _, other := range []struct {
+		context []byte
+		counter uint64
+	}{
+		{context: []byte("api"), counter: 2},
+		{context: []byte("api"), counter: 0},
+		{context: []byte("other"), counter: 1},
+		{context: nil, counter: 1},
+	} {
+		nonce, err := k.DeriveNonce(other.context, other.counter)
+		if err != nil {
+			t.Fatalf("DeriveNonce() error = %v", err)
+		}
+		if seen[string(nonce)] {
+			t.Errorf("DeriveNonce(%q, %d) repeats a nonce", other.context, other.counter)
+		}
+		seen[string(nonce)] = true
+	}
+
+	if _, err := (&node{}).DeriveNonce([]byte("api"), 1); err != ErrInvalidPrivateKey {
+		t.Errorf("DeriveNonce() error = %v, wantErr %v", err, ErrInvalidPrivateKey)
+	}
+}
@@ -0,0 +1,37 @@
+package slip10
+
+// DeriveForPathWithTransform derives key for the path and the seed as DeriveForPath does
+// and then replaces the key and chain code of the final node by the output of transform,
+// for experiments such as additive key blinding.
+// The transformed node is not a SLIP-0010 node: other implementations will not derive it,
+// and its descendants differ from those of the untransformed node.
+// Like nodes restored with NewNodeFromSubtree, it keeps its depth and index but has no origin
+// and no parent, so Path and NextAddress return errors.
+// transform receives copies it may modify; it must return a 32-byte key and a 32-byte chain code,
+// otherwise ErrInvalidPrivateKey or ErrInvalidChainCode is returned.
+func DeriveForPathWithTransform(path string, seed []byte, transform func(key, chainCode []byte) ([]byte, []byte, error)) (Node, error) {
+	derived, err := DeriveForPath(path, seed)
+	if err != nil {
+		return nil, err
+	}
+	k := derived.(*node)
+	defer k.wipe()
+
+	key, chainCode, err := transform(k.Subtree())
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != Ed25519KeyLen {
+		return nil, ErrInvalidPrivateKey
+	}
+	if len(chainCode) != ChainCodeLen {
+		return nil, ErrInvalidChainCode
+	}
+
+	return &node{
+		key:       append([]byte(nil), key...),
+		chainCode: append([]byte(nil), chainCode...),
+		depth:     k.depth,
+		index:     k.index,
+	}, nil
+}
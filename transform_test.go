@@ -0,0 +1,81 @@
+package slip10
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestDeriveForPathWithTransform(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	want, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	wantKey, wantChainCode := want.Subtree()
+
+	identity := func(key, chainCode []byte) ([]byte, []byte, error) {
+		return key, chainCode, nil
+	}
+	got, err := DeriveForPathWithTransform("m/0'/1'", seed, identity)
+	if err != nil {
+		t.Fatalf("DeriveForPathWithTransform() error = %v", err)
+	}
+	gotKey, gotChainCode := got.Subtree()
+	if !bytes.Equal(gotKey, wantKey) || !bytes.Equal(gotChainCode, wantChainCode) {
+		t.Errorf("DeriveForPathWithTransform() = %x %x, want %x %x", gotKey, gotChainCode, wantKey, wantChainCode)
+	}
+	if _, err := got.Path(); err != ErrUnknownOrigin {
+		t.Errorf("Path() error = %v, wantErr %v", err, ErrUnknownOrigin)
+	}
+	if _, err := got.NextAddress(); err != ErrNoParent {
+		t.Errorf("NextAddress() error = %v, wantErr %v", err, ErrNoParent)
+	}
+
+	blind := func(key, chainCode []byte) ([]byte, []byte, error) {
+		key[0] ^= 0x01
+		return key, chainCode, nil
+	}
+	blinded, err := DeriveForPathWithTransform("m/0'/1'", seed, blind)
+	if err != nil {
+		t.Fatalf("DeriveForPathWithTransform() error = %v", err)
+	}
+	if blinded.Fingerprint() == want.Fingerprint() {
+		t.Errorf("DeriveForPathWithTransform() did not change the public key")
+	}
+}
+
+func TestDeriveForPathWithTransform_Invalid(t *testing.T) {
+	errTransform := fmt.Errorf("transform failed")
+
+	tests := []struct {
+		name      string
+		transform func(key, chainCode []byte) ([]byte, []byte, error)
+		wantErr   error
+	}{
+		{
+			name:      "short key",
+			transform: func(key, chainCode []byte) ([]byte, []byte, error) { return key[:31], chainCode, nil },
+			wantErr:   ErrInvalidPrivateKey,
+		},
+		{
+			name:      "long chain code",
+			transform: func(key, chainCode []byte) ([]byte, []byte, error) { return key, append(chainCode, 0), nil },
+			wantErr:   ErrInvalidChainCode,
+		},
+		{
+			name:      "transform error",
+			transform: func(key, chainCode []byte) ([]byte, []byte, error) { return nil, nil, errTransform },
+			wantErr:   errTransform,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DeriveForPathWithTransform("m/0'", hexMustDecode("000102030405060708090a0b0c0d0e0f"), tt.transform)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("DeriveForPathWithTransform() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package slip10
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+)
+
+// uuidNamespace is the RFC 4122 v5 UUID of the URL "https://github.com/anyproto/go-slip10"
+// in the URL namespace. It must never change, otherwise all node UUIDs change.
+var uuidNamespace = []byte{
+	0x0e, 0x29, 0x64, 0x77, 0x1a, 0xea, 0x59, 0xcb,
+	0xaa, 0x2c, 0x01, 0x22, 0x5a, 0x82, 0x9a, 0xc4,
+}
+
+// UUID returns an RFC 4122 version 5 UUID with the node's public key as the name.
+// The UUID is computed from public data only: it is a stable identifier, not a secret.
+// It returns "" for nodes without a 32-byte private key.
+func (k *node) UUID() string {
+	pub, _, err := k.keypair()
+	if err != nil {
+		return ""
+	}
+
+	hash := sha1.New()
+	hash.Write(uuidNamespace)
+	hash.Write(pub)
+	sum := hash.Sum(nil)
+
+	sum[6] = sum[6]&0x0f | 0x50 // version 5
+	sum[8] = sum[8]&0x3f | 0x80 // RFC 4122 variant
+
+	buf := make([]byte, 36)
+	hex.Encode(buf[0:8], sum[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], sum[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], sum[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], sum[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], sum[10:16])
+	return string(buf)
+}
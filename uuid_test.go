@@ -0,0 +1,45 @@
+package slip10
+
+import "testing"
+
+func TestNode_UUID(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{
+			name: "Key(m) – master node",
+			path: "m",
+			want: "d569e737-aedd-5009-81c0-431b398cba9b",
+		},
+		{
+			name: "Key(m/0'/1')",
+			path: "m/0'/1'",
+			want: "37b36beb-c037-5581-9c1e-ef9a70071736",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := DeriveForPath(tt.path, seed)
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+
+			if got := node.UUID(); got != tt.want {
+				t.Errorf("UUID() = %v, want %v", got, tt.want)
+			}
+
+			// derived again from scratch, the node keeps the same UUID
+			again, err := DeriveForPath(tt.path, seed)
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+			if got := again.UUID(); got != tt.want {
+				t.Errorf("UUID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package slip10
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// Vector is a test vector in the layout of the slip-10 examples.
+type Vector struct {
+	Path string `json:"path"`
+	// ChainCodeHex is the hex encoded chain code.
+	ChainCodeHex string `json:"chainCode"`
+	// PrivateHex is the hex encoded 32-byte private key.
+	PrivateHex string `json:"private"`
+	// PublicHex is the hex encoded public key with the 0x00 prefix, as returned by PublicKeyWithPrefix.
+	PublicHex string `json:"public"`
+}
+
+// GenerateTestVectors derives every path from the seed on the curve and returns
+// the resulting test vectors in the same order, e.g. to write reproducible vector files
+// and diff them against other slip-10 implementations.
+// Paths are in the format of DeriveForPath; errors are wrapped with the failing path.
+func GenerateTestVectors(seed []byte, curve Curve, paths []string) ([]Vector, error) {
+	if curve != CurveEd25519 {
+		return nil, ErrUnknownCurve
+	}
+
+	vectors := make([]Vector, 0, len(paths))
+	for _, path := range paths {
+		key, err := DeriveForPath(path, seed)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", err, path)
+		}
+
+		n := key.(*node)
+		vectors = append(vectors, Vector{
+			Path:         path,
+			ChainCodeHex: hex.EncodeToString(n.chainCode),
+			PrivateHex:   hex.EncodeToString(n.key),
+			PublicHex:    hex.EncodeToString(n.PublicKeyWithPrefix()),
+		})
+	}
+
+	return vectors, nil
+}
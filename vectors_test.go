@@ -0,0 +1,45 @@
+package slip10
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestGenerateTestVectors(t *testing.T) {
+	// https://github.com/satoshilabs/slips/blob/master/slip-0010.md#test-vector-1-for-ed25519
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	got, err := GenerateTestVectors(seed, CurveEd25519, []string{"m", "m/0'"})
+	if err != nil {
+		t.Fatalf("GenerateTestVectors() error = %v", err)
+	}
+	want := []Vector{
+		{
+			Path:         "m",
+			ChainCodeHex: "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb",
+			PrivateHex:   "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7",
+			PublicHex:    "00a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed",
+		},
+		{
+			Path:         "m/0'",
+			ChainCodeHex: "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69",
+			PrivateHex:   "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+			PublicHex:    "008c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GenerateTestVectors() = %+v, want %+v", got, want)
+	}
+}
+
+func TestGenerateTestVectors_Invalid(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	if _, err := GenerateTestVectors(seed, CurveEd25519, []string{"m/0'", "m/0"}); !errors.Is(err, ErrInvalidPath) {
+		t.Errorf("GenerateTestVectors() error = %v, wantErr %v", err, ErrInvalidPath)
+	}
+	if _, err := GenerateTestVectors(seed, Curve(42), []string{"m"}); err != ErrUnknownCurve {
+		t.Errorf("GenerateTestVectors() error = %v, wantErr %v", err, ErrUnknownCurve)
+	}
+}
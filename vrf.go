@@ -0,0 +1,165 @@
+package slip10
+
+import (
+	"crypto/sha512"
+	"crypto/subtle"
+	"fmt"
+
+	"filippo.io/edwards25519"
+)
+
+// ECVRF-EDWARDS25519-SHA512-TAI as in https://www.rfc-editor.org/rfc/rfc9381
+const (
+	vrfSuite = 0x03
+	// vrfChallengeLen is the length of the challenge c in a proof.
+	vrfChallengeLen = 16
+	// VRFProofLen is the length of a proof: Gamma || c || s.
+	VRFProofLen = 32 + vrfChallengeLen + 32
+	// VRFOutputLen is the length of a VRF output (beta).
+	VRFOutputLen = sha512.Size
+)
+
+var ErrVRFEncodeToCurve = fmt.Errorf("vrf: failed to encode input to curve")
+
+// VRFProve computes the ECVRF-EDWARDS25519-SHA512-TAI (RFC 9381) output and proof
+// for the input alpha, keyed by the node's private key.
+// Anyone with the node's public key can check the output with VRFVerify.
+func (k *node) VRFProve(alpha []byte) (output, proof []byte, err error) {
+	if k == nil {
+		return nil, nil, ErrNilNode
+	}
+	h := sha512.Sum512(k.key)
+	x, err := edwards25519.NewScalar().SetBytesWithClamping(h[:32])
+	if err != nil {
+		return nil, nil, err
+	}
+	pk := new(edwards25519.Point).ScalarBaseMult(x).Bytes()
+
+	hPoint, err := vrfEncodeToCurve(pk, alpha)
+	if err != nil {
+		return nil, nil, err
+	}
+	hString := hPoint.Bytes()
+	gamma := new(edwards25519.Point).ScalarMult(x, hPoint)
+
+	// nonce generation as in RFC 8032
+	nonceHash := sha512.New()
+	nonceHash.Write(h[32:])
+	nonceHash.Write(hString)
+	nonce, err := edwards25519.NewScalar().SetUniformBytes(nonceHash.Sum(nil))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u := new(edwards25519.Point).ScalarBaseMult(nonce)
+	v := new(edwards25519.Point).ScalarMult(nonce, hPoint)
+	c := vrfChallenge(pk, hString, gamma.Bytes(), u.Bytes(), v.Bytes())
+	cScalar, err := vrfChallengeScalar(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	s := edwards25519.NewScalar().MultiplyAdd(cScalar, x, nonce)
+
+	proof = make([]byte, 0, VRFProofLen)
+	proof = append(proof, gamma.Bytes()...)
+	proof = append(proof, c...)
+	proof = append(proof, s.Bytes()...)
+	return vrfProofToHash(gamma), proof, nil
+}
+
+// VRFVerify checks that proof and output were produced by VRFProve for the input alpha
+// with the private key of the 32-byte ed25519 public key.
+func VRFVerify(publicKey, alpha, output, proof []byte) bool {
+	if len(publicKey) != Ed25519KeyLen || len(proof) != VRFProofLen || len(output) != VRFOutputLen {
+		return false
+	}
+
+	y, err := new(edwards25519.Point).SetBytes(publicKey)
+	if err != nil {
+		return false
+	}
+	// reject small order public keys
+	if new(edwards25519.Point).MultByCofactor(y).Equal(edwards25519.NewIdentityPoint()) == 1 {
+		return false
+	}
+
+	gamma, err := new(edwards25519.Point).SetBytes(proof[:32])
+	if err != nil {
+		return false
+	}
+	c := proof[32 : 32+vrfChallengeLen]
+	cScalar, err := vrfChallengeScalar(c)
+	if err != nil {
+		return false
+	}
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(proof[32+vrfChallengeLen:])
+	if err != nil {
+		return false
+	}
+
+	hPoint, err := vrfEncodeToCurve(publicKey, alpha)
+	if err != nil {
+		return false
+	}
+
+	// U = s*B - c*Y, V = s*H - c*Gamma
+	negC := edwards25519.NewScalar().Negate(cScalar)
+	u := new(edwards25519.Point).VarTimeDoubleScalarBaseMult(negC, y, s)
+	v := new(edwards25519.Point).VarTimeMultiScalarMult(
+		[]*edwards25519.Scalar{s, negC},
+		[]*edwards25519.Point{hPoint, gamma},
+	)
+
+	expected := vrfChallenge(publicKey, hPoint.Bytes(), gamma.Bytes(), u.Bytes(), v.Bytes())
+	if subtle.ConstantTimeCompare(c, expected) != 1 {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(output, vrfProofToHash(gamma)) == 1
+}
+
+// vrfEncodeToCurve is the try-and-increment encode_to_curve of RFC 9381, section 5.4.1.1.
+func vrfEncodeToCurve(pk, alpha []byte) (*edwards25519.Point, error) {
+	for ctr := 0; ctr < 256; ctr++ {
+		hash := sha512.New()
+		hash.Write([]byte{vrfSuite, 0x01})
+		hash.Write(pk)
+		hash.Write(alpha)
+		hash.Write([]byte{byte(ctr), 0x00})
+		sum := hash.Sum(nil)
+
+		p, err := new(edwards25519.Point).SetBytes(sum[:32])
+		if err != nil {
+			continue
+		}
+		return p.MultByCofactor(p), nil
+	}
+
+	return nil, ErrVRFEncodeToCurve
+}
+
+// vrfChallenge is the challenge_generation of RFC 9381, section 5.4.3.
+func vrfChallenge(points ...[]byte) []byte {
+	hash := sha512.New()
+	hash.Write([]byte{vrfSuite, 0x02})
+	for _, p := range points {
+		hash.Write(p)
+	}
+	hash.Write([]byte{0x00})
+	return hash.Sum(nil)[:vrfChallengeLen]
+}
+
+func vrfChallengeScalar(c []byte) (*edwards25519.Scalar, error) {
+	buf := make([]byte, 32)
+	copy(buf, c)
+	return edwards25519.NewScalar().SetCanonicalBytes(buf)
+}
+
+// vrfProofToHash is the proof_to_hash of RFC 9381, section 5.2.
+func vrfProofToHash(gamma *edwards25519.Point) []byte {
+	hash := sha512.New()
+	hash.Write([]byte{vrfSuite, 0x03})
+	hash.Write(new(edwards25519.Point).MultByCofactor(gamma).Bytes())
+	hash.Write([]byte{0x00})
+	return hash.Sum(nil)
+}
@@ -0,0 +1,112 @@
+package slip10
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNode_VRFProve(t *testing.T) {
+	// test vectors from https://www.rfc-editor.org/rfc/rfc9381#appendix-B.3
+	tests := []struct {
+		name       string
+		sk         []byte
+		pk         []byte
+		alpha      []byte
+		wantProof  []byte
+		wantOutput []byte
+	}{
+		{
+			name:       "Example 16",
+			sk:         hexMustDecode("9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60"),
+			pk:         hexMustDecode("d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a"),
+			alpha:      []byte{},
+			wantProof:  hexMustDecode("8657106690b5526245a92b003bb079ccd1a92130477671f6fc01ad16f26f723f26f8a57ccaed74ee1b190bed1f479d9727d2d0f9b005a6e456a35d4fb0daab1268a1b0db10836d9826a528ca76567805"),
+			wantOutput: hexMustDecode("90cf1df3b703cce59e2a35b925d411164068269d7b2d29f3301c03dd757876ff66b71dda49d2de59d03450451af026798e8f81cd2e333de5cdf4f3e140fdd8ae"),
+		},
+		{
+			name:       "Example 17",
+			sk:         hexMustDecode("4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb"),
+			pk:         hexMustDecode("3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c"),
+			alpha:      hexMustDecode("72"),
+			wantProof:  hexMustDecode("f3141cd382dc42909d19ec5110469e4feae18300e94f304590abdced48aed5933bf0864a62558b3ed7f2fea45c92a465301b3bbf5e3e54ddf2d935be3b67926da3ef39226bbc355bdc9850112c8f4b02"),
+			wantOutput: hexMustDecode("eb4440665d3891d668e7e0fcaf587f1b4bd7fbfe99d0eb2211ccec90496310eb5e33821bc613efb94db5e5b54c70a848a0bef4553a41befc57663b56373a5031"),
+		},
+		{
+			name:       "Example 18",
+			sk:         hexMustDecode("c5aa8df43f9f837bedb7442f31dcb7b166d38535076f094b85ce3a2e0b4458f7"),
+			pk:         hexMustDecode("fc51cd8e6218a1a38da47ed00230f0580816ed13ba3303ac5deb911548908025"),
+			alpha:      hexMustDecode("af82"),
+			wantProof:  hexMustDecode("9bc0f79119cc5604bf02d23b4caede71393cedfbb191434dd016d30177ccbf8096bb474e53895c362d8628ee9f9ea3c0e52c7a5c691b6c18c9979866568add7a2d41b00b05081ed0f58ee5e31b3a970e"),
+			wantOutput: hexMustDecode("645427e5d00c62a23fb703732fa5d892940935942101e456ecca7bb217c61c452118fec1219202a0edcf038bb6373241578be7217ba85a2687f7a0310b2df19f"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := &node{key: tt.sk}
+
+			output, proof, err := k.VRFProve(tt.alpha)
+			if err != nil {
+				t.Fatalf("VRFProve() error = %v", err)
+			}
+			if !bytes.Equal(proof, tt.wantProof) {
+				t.Errorf("VRFProve() proof = %x, want %x", proof, tt.wantProof)
+			}
+			if !bytes.Equal(output, tt.wantOutput) {
+				t.Errorf("VRFProve() output = %x, want %x", output, tt.wantOutput)
+			}
+
+			if !VRFVerify(tt.pk, tt.alpha, output, proof) {
+				t.Errorf("VRFVerify() = false, want true")
+			}
+		})
+	}
+}
+
+func TestVRFVerify_Invalid(t *testing.T) {
+	node, err := DeriveForPath("m/0'/1'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	pub, _ := node.Keypair()
+	alpha := []byte("alpha")
+
+	output, proof, err := node.VRFProve(alpha)
+	if err != nil {
+		t.Fatalf("VRFProve() error = %v", err)
+	}
+	if !VRFVerify(pub, alpha, output, proof) {
+		t.Fatalf("VRFVerify() = false, want true")
+	}
+
+	tamperedProof := append([]byte(nil), proof...)
+	tamperedProof[40] ^= 0x01
+	tamperedOutput := append([]byte(nil), output...)
+	tamperedOutput[0] ^= 0x01
+	other, err := DeriveForPath("m/0'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	otherPub, _ := other.Keypair()
+
+	tests := []struct {
+		name   string
+		pub    []byte
+		alpha  []byte
+		output []byte
+		proof  []byte
+	}{
+		{name: "other input", pub: pub, alpha: []byte("beta"), output: output, proof: proof},
+		{name: "other public key", pub: otherPub, alpha: alpha, output: output, proof: proof},
+		{name: "tampered proof", pub: pub, alpha: alpha, output: output, proof: tamperedProof},
+		{name: "tampered output", pub: pub, alpha: alpha, output: tamperedOutput, proof: proof},
+		{name: "short proof", pub: pub, alpha: alpha, output: output, proof: proof[:VRFProofLen-1]},
+		{name: "small order public key", pub: make([]byte, 32), alpha: alpha, output: output, proof: proof},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if VRFVerify(tt.pub, tt.alpha, tt.output, tt.proof) {
+				t.Errorf("VRFVerify() = true, want false")
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package slip10
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestBIP39English(t *testing.T) {
+	if len(bip39English) != 2048 {
+		t.Fatalf("len(bip39English) = %d, want 2048", len(bip39English))
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(bip39English, "\n") + "\n"))
+	if got, want := hex.EncodeToString(sum[:]), "2f5eed53a4727b4bf8880d8f3f199efc90e58503646d9ff8eff3a2ed3b24dbda"; got != want {
+		t.Errorf("sha256(english.txt) = %v, want %v", got, want)
+	}
+}
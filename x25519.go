@@ -0,0 +1,79 @@
+package slip10
+
+import (
+	"crypto/ecdh"
+	"crypto/sha512"
+	"encoding/base64"
+	"strings"
+)
+
+// X25519PrivateKey returns the X25519 private key matching the node's ed25519 key,
+// as libsodium's crypto_sign_ed25519_sk_to_curve25519 does: the clamped first half
+// of the SHA-512 hash of the private key seed.
+func (k *node) X25519PrivateKey() []byte {
+	if k == nil {
+		return nil
+	}
+	h := sha512.Sum512(k.key)
+	h[0] &= 248
+	h[31] &= 127
+	h[31] |= 64
+	return append([]byte(nil), h[:32]...)
+}
+
+// X25519PublicKey returns the X25519 public key of X25519PrivateKey,
+// which is the Montgomery form of the node's ed25519 public key.
+func (k *node) X25519PublicKey() ([]byte, error) {
+	if k == nil {
+		return nil, ErrNilNode
+	}
+	priv, err := ecdh.X25519().NewPrivateKey(k.X25519PrivateKey())
+	if err != nil {
+		return nil, err
+	}
+
+	return priv.PublicKey().Bytes(), nil
+}
+
+// AgeX25519Identity returns the node's X25519 private key as an age identity, "AGE-SECRET-KEY-1...".
+// Anyone with the identity can decrypt files encrypted to AgeRecipient.
+func (k *node) AgeX25519Identity() (string, error) {
+	if k == nil {
+		return "", ErrNilNode
+	}
+	identity, err := bech32Encode("age-secret-key-", k.X25519PrivateKey())
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ToUpper(identity), nil
+}
+
+// AgeRecipient returns the node's X25519 public key as an age recipient, "age1...".
+func (k *node) AgeRecipient() (string, error) {
+	if k == nil {
+		return "", ErrNilNode
+	}
+	pub, err := k.X25519PublicKey()
+	if err != nil {
+		return "", err
+	}
+
+	return bech32Encode("age", pub)
+}
+
+// WireGuardKeys returns the node's X25519 key pair in the format of wg genkey and wg pubkey:
+// the standard base64 encoding of X25519PrivateKey, which is already clamped
+// (bits 0, 1, 2 and 255 cleared, bit 254 set), and of X25519PublicKey.
+// The same node always gives the same keys, so a VPN configuration can be regenerated from the seed.
+func (k *node) WireGuardKeys() (privateBase64, publicBase64 string, err error) {
+	if k == nil {
+		return "", "", ErrNilNode
+	}
+	pub, err := k.X25519PublicKey()
+	if err != nil {
+		return "", "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(k.X25519PrivateKey()), base64.StdEncoding.EncodeToString(pub), nil
+}
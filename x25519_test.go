@@ -0,0 +1,173 @@
+package slip10
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	"filippo.io/age"
+	"filippo.io/edwards25519"
+	"golang.org/x/crypto/curve25519"
+)
+
+func TestNode_X25519PublicKey(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+
+	for _, path := range []string{"m", "m/0'", "m/0'/1'", "m/0'/1'/2'"} {
+		t.Run(path, func(t *testing.T) {
+			node, err := DeriveForPath(path, seed)
+			if err != nil {
+				t.Fatalf("DeriveForPath() error = %v", err)
+			}
+
+			got, err := node.X25519PublicKey()
+			if err != nil {
+				t.Fatalf("X25519PublicKey() error = %v", err)
+			}
+
+			// the X25519 public key is the Montgomery form of the ed25519 public key
+			pub, _ := node.Keypair()
+			point, err := new(edwards25519.Point).SetBytes(pub)
+			if err != nil {
+				t.Fatalf("SetBytes() error = %v", err)
+			}
+			if want := point.BytesMontgomery(); !bytes.Equal(got, want) {
+				t.Errorf("X25519PublicKey() = %x, want %x", got, want)
+			}
+		})
+	}
+}
+
+func TestNode_X25519SharedSecret(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	alice, err := DeriveForPath("m/0'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+	bob, err := DeriveForPath("m/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	alicePub, err := alice.X25519PublicKey()
+	if err != nil {
+		t.Fatalf("X25519PublicKey() error = %v", err)
+	}
+	bobPub, err := bob.X25519PublicKey()
+	if err != nil {
+		t.Fatalf("X25519PublicKey() error = %v", err)
+	}
+
+	aliceShared, err := curve25519.X25519(alice.X25519PrivateKey(), bobPub)
+	if err != nil {
+		t.Fatalf("X25519() error = %v", err)
+	}
+	bobShared, err := curve25519.X25519(bob.X25519PrivateKey(), alicePub)
+	if err != nil {
+		t.Fatalf("X25519() error = %v", err)
+	}
+	if !bytes.Equal(aliceShared, bobShared) {
+		t.Errorf("shared secrets differ: %x and %x", aliceShared, bobShared)
+	}
+}
+
+func TestNode_Age(t *testing.T) {
+	seed := hexMustDecode("000102030405060708090a0b0c0d0e0f")
+	node, err := DeriveForPath("m/0'/1'", seed)
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	identity, err := node.AgeX25519Identity()
+	if err != nil {
+		t.Fatalf("AgeX25519Identity() error = %v", err)
+	}
+	if want := "AGE-SECRET-KEY-1WQLAY459N0EVUMV4CSY2TD5ZYAS97UZ5J7QLAJDPFZE4QSC7E48SLX008E"; identity != want {
+		t.Errorf("AgeX25519Identity() = %v, want %v", identity, want)
+	}
+
+	recipient, err := node.AgeRecipient()
+	if err != nil {
+		t.Fatalf("AgeRecipient() error = %v", err)
+	}
+	if want := "age1g4enu4vcy8cxqjwc060f2ywfknn34apmkah95e7d50aaqa2r9pmqpedgvu"; recipient != want {
+		t.Errorf("AgeRecipient() = %v, want %v", recipient, want)
+	}
+
+	// the strings must also be accepted by age itself and form a working key pair
+	parsedIdentity, err := age.ParseX25519Identity(identity)
+	if err != nil {
+		t.Fatalf("age.ParseX25519Identity() error = %v", err)
+	}
+	parsedRecipient, err := age.ParseX25519Recipient(recipient)
+	if err != nil {
+		t.Fatalf("age.ParseX25519Recipient() error = %v", err)
+	}
+	if got := parsedIdentity.Recipient().String(); got != recipient {
+		t.Errorf("identity recipient = %v, want %v", got, recipient)
+	}
+
+	var encrypted bytes.Buffer
+	w, err := age.Encrypt(&encrypted, parsedRecipient)
+	if err != nil {
+		t.Fatalf("age.Encrypt() error = %v", err)
+	}
+	plaintext := []byte("slip10 age round trip")
+	if _, err := w.Write(plaintext); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	r, err := age.Decrypt(&encrypted, parsedIdentity)
+	if err != nil {
+		t.Fatalf("age.Decrypt() error = %v", err)
+	}
+	decrypted, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if !bytes.Equal(decrypted, plaintext) {
+		t.Errorf("decrypted = %q, want %q", decrypted, plaintext)
+	}
+}
+
+func TestNode_WireGuardKeys(t *testing.T) {
+	node, err := DeriveForPath("m/0'/1'", hexMustDecode("000102030405060708090a0b0c0d0e0f"))
+	if err != nil {
+		t.Fatalf("DeriveForPath() error = %v", err)
+	}
+
+	privateBase64, publicBase64, err := node.WireGuardKeys()
+	if err != nil {
+		t.Fatalf("WireGuardKeys() error = %v", err)
+	}
+	priv, err := base64.StdEncoding.DecodeString(privateBase64)
+	if err != nil || len(priv) != curve25519.ScalarSize {
+		t.Fatalf("WireGuardKeys() private key = %q, not %d base64 bytes", privateBase64, curve25519.ScalarSize)
+	}
+	pub, err := base64.StdEncoding.DecodeString(publicBase64)
+	if err != nil || len(pub) != curve25519.PointSize {
+		t.Fatalf("WireGuardKeys() public key = %q, not %d base64 bytes", publicBase64, curve25519.PointSize)
+	}
+
+	if priv[0]&7 != 0 || priv[31]&0xc0 != 0x40 {
+		t.Errorf("WireGuardKeys() private key %x is not clamped", priv)
+	}
+	want, err := curve25519.X25519(priv, curve25519.Basepoint)
+	if err != nil {
+		t.Fatalf("X25519() error = %v", err)
+	}
+	if !bytes.Equal(pub, want) {
+		t.Errorf("WireGuardKeys() public key = %x, want %x", pub, want)
+	}
+
+	again, _, err := node.WireGuardKeys()
+	if err != nil {
+		t.Fatalf("WireGuardKeys() error = %v", err)
+	}
+	if again != privateBase64 {
+		t.Errorf("WireGuardKeys() is not deterministic: %v, then %v", privateBase64, again)
+	}
+}